@@ -1,10 +1,111 @@
 package qiscus
 
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+)
+
+// Sentinel errors to classify an Error with errors.Is:
+//
+//	if errors.Is(err, qiscus.ErrNotFound) { ... }
+var (
+	// ErrUnauthorized is returned when the credentials are invalid or not allowed (HTTP 401 and 403)
+	ErrUnauthorized = errors.New("qiscus: unauthorized")
+
+	// ErrNotFound is returned when the resource does not exist (HTTP 404)
+	ErrNotFound = errors.New("qiscus: not found")
+
+	// ErrRateLimited is returned when too many requests are sent (HTTP 429)
+	ErrRateLimited = errors.New("qiscus: rate limited")
+
+	// ErrValidation is returned when the request is rejected as invalid (HTTP 400 and 422)
+	ErrValidation = errors.New("qiscus: validation failed")
+
+	// ErrTransport is returned when no response is received because of a network, timeout or context error
+	ErrTransport = errors.New("qiscus: transport error")
+)
+
+// Error is returned by every call to Qiscus API, it implements the error interface.
+// Note that methods return *Error, assign the result to an error variable only when it is not nil.
 type Error struct {
 	Message        string
 	StatusCode     int
 	RawError       error
 	RawApiResponse *APIResponse
+
+	// Error details decoded from Qiscus API error response body
+	Code             string              // API error code, if any
+	APIMessage       string              // error message returned by the API
+	DetailedMessages []string            // detailed error messages returned by the API
+	FieldErrors      map[string][]string // validation error messages per request field
+}
+
+// Error returns the general message error, it implements the error interface
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the raw error, so errors.Is and errors.As can inspect it, e.g. context.DeadlineExceeded
+func (e *Error) Unwrap() error {
+	return e.RawError
+}
+
+// Is reports whether the error matches one of the sentinel errors
+func (e *Error) Is(target error) bool {
+	kind := e.Kind()
+	return kind != nil && kind == target
+}
+
+// Kind returns the sentinel error classifying the error, or nil when it matches none of them
+func (e *Error) Kind() error {
+	if len(e.FieldErrors) > 0 && e.StatusCode < 500 {
+		return ErrValidation
+	}
+
+	switch e.StatusCode {
+	case 0:
+		if e.RawApiResponse == nil && isTransportError(e.RawError) {
+			return ErrTransport
+		}
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrUnauthorized
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ErrValidation
+	}
+
+	return nil
+}
+
+// isTransportError reports whether err happened while exchanging with the server,
+// as opposed to errors raised before sending such as an invalid URL
+func isTransportError(err error) bool {
+	// HTTP client errors are wrapped in url.Error, so are URL parse errors
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+
+	var netErr net.Error
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return true
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return true
+	case errors.As(err, &netErr):
+		return true
+	}
+
+	return false
 }
 
 // GetMessage this get general message error when call api
@@ -22,6 +123,19 @@ func (e *Error) GetRawError() error {
 	return e.RawError
 }
 
+// GetAPIMessage this get error message returned by qiscus backend, or the general message error when there is none
+func (e *Error) GetAPIMessage() string {
+	if e.APIMessage != "" {
+		return e.APIMessage
+	}
+	return e.Message
+}
+
+// GetFieldErrors this get validation error messages per request field returned by qiscus backend
+func (e *Error) GetFieldErrors() map[string][]string {
+	return e.FieldErrors
+}
+
 // GetRawApiResponse this get api raw response from qiscus backend
 func (e *Error) GetRawApiResponse() *APIResponse {
 	return e.RawApiResponse
@@ -0,0 +1,143 @@
+package qiscus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorIs(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		want       error
+	}{
+		{http.StatusBadRequest, ErrValidation},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrUnauthorized},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusUnprocessableEntity, ErrValidation},
+		{http.StatusTooManyRequests, ErrRateLimited},
+	}
+
+	for _, tt := range tests {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(tt.statusCode)
+			fmt.Fprint(w, `{"status":"error"}`)
+		}))
+
+		e := NewHttpRequest(http.MethodGet, srv.URL, nil, &struct{}{}).DoRequest()
+		srv.Close()
+
+		var err error = e
+		assert.True(t, errors.Is(err, tt.want), "status code %d", tt.statusCode)
+		assert.Contains(t, err.Error(), "qiscus api is returning error")
+
+		var qErr *Error
+		assert.True(t, errors.As(err, &qErr))
+		assert.Equal(t, qErr.StatusCode, tt.statusCode)
+	}
+}
+
+func TestErrorTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {}))
+	srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var err error = DoRequestContext(ctx, NewHttpRequest(http.MethodGet, srv.URL, nil, nil))
+	assert.True(t, errors.Is(err, ErrTransport))
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.False(t, errors.Is(err, ErrNotFound))
+
+	// Connection refused
+	err = NewHttpRequest(http.MethodGet, srv.URL, nil, nil).DoRequest()
+	assert.True(t, errors.Is(err, ErrTransport))
+}
+
+func TestErrorNotTransport(t *testing.T) {
+	// Invalid URL, the request is never sent
+	var err error = NewHttpRequest(http.MethodGet, "http://[::1", nil, nil).DoRequest()
+	assert.NotNil(t, err)
+	assert.False(t, errors.Is(err, ErrTransport))
+
+	err = NewHttpRequest(http.MethodGet, "ftp://example.com", nil, nil).DoRequest()
+	assert.NotNil(t, err)
+	assert.False(t, errors.Is(err, ErrTransport))
+
+	// Client side validation error
+	err = &Error{Message: "room_id is required", FieldErrors: map[string][]string{"room_id": {"is required"}}}
+	assert.True(t, errors.Is(err, ErrValidation))
+	assert.False(t, errors.Is(err, ErrTransport))
+
+	err = &Error{Message: "cannot read file", RawError: errors.New("read failed")}
+	assert.Nil(t, err.(*Error).Kind())
+}
+
+func TestErrorBody(t *testing.T) {
+	tests := []struct {
+		name             string
+		body             string
+		apiMessage       string
+		detailedMessages []string
+		fieldErrors      map[string][]string
+		code             string
+	}{
+		{
+			name:             "sdk",
+			body:             `{"error":{"message":"room not found","detailed_messages":["room not found"]},"status":400}`,
+			apiMessage:       "room not found",
+			detailedMessages: []string{"room not found"},
+		},
+		{
+			name:       "multichannel message",
+			body:       `{"errors":"Unauthorized","status":401}`,
+			apiMessage: "Unauthorized",
+		},
+		{
+			name:        "multichannel fields",
+			body:        `{"errors":{"message":"invalid request","room_id":["can't be blank"],"agent_id":"is invalid"},"code":1001}`,
+			apiMessage:  "invalid request",
+			fieldErrors: map[string][]string{"room_id": {"can't be blank"}, "agent_id": {"is invalid"}},
+			code:        "1001",
+		},
+		{
+			name: "not json",
+			body: `<html>Bad Gateway</html>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &Error{}
+			e.decodeErrorBody([]byte(tt.body))
+
+			assert.Equal(t, e.APIMessage, tt.apiMessage)
+			assert.Equal(t, e.DetailedMessages, tt.detailedMessages)
+			assert.Equal(t, e.FieldErrors, tt.fieldErrors)
+			assert.Equal(t, e.Code, tt.code)
+		})
+	}
+}
+
+func TestErrorFieldErrorsIsValidation(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"errors":{"tag":["has already been taken"]}}`)
+	}))
+
+	defer srv.Close()
+
+	var err error = NewHttpRequest(http.MethodPost, srv.URL, nil, &struct{}{}).DoRequest()
+	assert.True(t, errors.Is(err, ErrValidation))
+
+	var qErr *Error
+	assert.True(t, errors.As(err, &qErr))
+	assert.Equal(t, qErr.GetFieldErrors()["tag"], []string{"has already been taken"})
+	assert.Equal(t, qErr.GetAPIMessage(), qErr.GetMessage())
+}
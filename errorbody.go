@@ -0,0 +1,128 @@
+package qiscus
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// decodeErrorBody fills the API error details of e from the response body.
+// It understands the SDK envelope {"error": {"message": "...", "detailed_messages": [...]}, "status": 400}
+// and the Multichannel envelopes {"errors": "..."}, {"errors": {"message": "..."}} and {"errors": {"field": ["..."]}}.
+func (e *Error) decodeErrorBody(body []byte) {
+	var envelope map[string]json.RawMessage
+	if json.Unmarshal(body, &envelope) != nil {
+		return
+	}
+
+	for _, key := range []string{"error", "errors"} {
+		if raw, ok := envelope[key]; ok {
+			e.decodeErrorValue(raw)
+		}
+	}
+
+	if e.APIMessage == "" {
+		e.APIMessage = rawString(envelope["message"])
+	}
+
+	for _, key := range []string{"code", "error_code"} {
+		if e.Code == "" {
+			e.Code = rawString(envelope[key])
+		}
+	}
+}
+
+// decodeErrorValue decodes the value of "error" or "errors" key
+func (e *Error) decodeErrorValue(raw json.RawMessage) {
+	// A plain message
+	var message string
+	if json.Unmarshal(raw, &message) == nil {
+		if e.APIMessage == "" {
+			e.APIMessage = message
+		}
+		return
+	}
+
+	// A list of messages
+	var messages []string
+	if json.Unmarshal(raw, &messages) == nil {
+		e.DetailedMessages = append(e.DetailedMessages, messages...)
+		return
+	}
+
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(raw, &fields) != nil {
+		return
+	}
+
+	for name, value := range fields {
+		switch name {
+		case "message":
+			if e.APIMessage == "" {
+				e.APIMessage = rawString(value)
+			}
+		case "detailed_messages":
+			var details []string
+			if json.Unmarshal(value, &details) == nil {
+				e.DetailedMessages = append(e.DetailedMessages, details...)
+			}
+		case "code", "error_code":
+			e.Code = rawString(value)
+		default:
+			// Any other key is a field validation error, either a message or a list of messages
+			if errs := rawStrings(value); len(errs) > 0 {
+				if e.FieldErrors == nil {
+					e.FieldErrors = make(map[string][]string)
+				}
+				e.FieldErrors[name] = append(e.FieldErrors[name], errs...)
+			}
+		}
+	}
+
+	if e.APIMessage == "" && len(e.DetailedMessages) > 0 {
+		e.APIMessage = strings.Join(e.DetailedMessages, ", ")
+	}
+}
+
+// rawString decodes a JSON string or number into string
+func rawString(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+
+	var n json.Number
+	if json.Unmarshal(raw, &n) == nil {
+		return n.String()
+	}
+
+	return ""
+}
+
+// rawStrings decodes a JSON string or list of strings
+func rawStrings(raw json.RawMessage) []string {
+	if s := rawString(raw); s != "" {
+		return []string{s}
+	}
+
+	var list []interface{}
+	if json.Unmarshal(raw, &list) != nil {
+		return nil
+	}
+
+	var result []string
+	for _, v := range list {
+		switch v := v.(type) {
+		case string:
+			result = append(result, v)
+		case float64:
+			result = append(result, strconv.FormatFloat(v, 'f', -1, 64))
+		}
+	}
+
+	return result
+}
@@ -2,6 +2,7 @@ package qiscus
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,6 +11,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
 
@@ -45,24 +47,71 @@ type HttpRequest interface {
 	AddParameter(name, value string)
 }
 
+// HttpRequestSettings is implemented by requests accepting per-request HTTP settings, e.g. HttpRequestImpl.
+// It is kept apart from HttpRequest so existing implementations of HttpRequest keep compiling,
+// check for it with a type assertion.
+type HttpRequestSettings interface {
+	SetRetryPolicy(policy *RetryPolicy)
+	SetHttpClient(client *http.Client)
+	SetUserAgent(userAgent string)
+	SetLogger(logger *zerolog.Logger)
+	SetContentType(contentType string)
+	SetStreaming(streaming bool)
+}
+
+// HttpRequestContext is implemented by requests accepting a context, e.g. HttpRequestImpl.
+// It is kept apart from HttpRequest for the same reason as HttpRequestSettings, use DoRequestContext to send any HttpRequest with a context.
+type HttpRequestContext interface {
+	DoRequestContext(ctx context.Context) *Error
+}
+
+var (
+	_ HttpRequestSettings = (*HttpRequestImpl)(nil)
+	_ HttpRequestContext  = (*HttpRequestImpl)(nil)
+)
+
+// DoRequestContext sends r with ctx when r implements HttpRequestContext,
+// otherwise it checks ctx before falling back to DoRequest.
+func DoRequestContext(ctx context.Context, r HttpRequest) *Error {
+	if rc, ok := r.(HttpRequestContext); ok {
+		return rc.DoRequestContext(ctx)
+	}
+	if err := ctx.Err(); err != nil {
+		return &Error{
+			Message:  fmt.Sprintf("error when request via http client, cannot send request with error: %s", err.Error()),
+			RawError: err,
+		}
+	}
+	return r.DoRequest()
+}
+
 // HttpRequestImpl : this is for Qiscus HttpClient Implementation
 type HttpRequestImpl struct {
-	Method     string
-	URL        string
-	Body       io.Reader
-	Headers    map[string]string
-	Parameters map[string][]string
-	Response   interface{}
-	HttpClient *http.Client
+	Method      string
+	URL         string
+	Body        io.Reader
+	Headers     map[string]string
+	Parameters  map[string][]string
+	Response    interface{}
+	HttpClient  *http.Client
+	RetryPolicy *RetryPolicy
+	UserAgent   string
+	Logger      *zerolog.Logger // logger for HTTP outbound log, default is the global zerolog logger
+	OutboundLog bool
+	ContentType string // Content-Type header of the request, default is application/json
+	Streaming   bool   // the body is streamed as is, without buffering nor retry
 }
 
 func NewHttpRequest(method string, url string, body io.Reader, response interface{}) HttpRequest {
 	return &HttpRequestImpl{
-		Method:     method,
-		URL:        url,
-		Body:       body,
-		Response:   response,
-		HttpClient: DefaultHttpClient,
+		Method:      method,
+		URL:         url,
+		Body:        body,
+		Response:    response,
+		HttpClient:  DefaultHttpClient,
+		RetryPolicy: DefaultRetryPolicy,
+		UserAgent:   DefaultUserAgent,
+		OutboundLog: DefaultHttpOutboundLog,
 	}
 }
 
@@ -80,25 +129,143 @@ func (r *HttpRequestImpl) AddParameter(name, value string) {
 	r.Parameters[name] = append(r.Parameters[name], value)
 }
 
+// SetRetryPolicy sets the retry policy of the request, nil disables retry
+func (r *HttpRequestImpl) SetRetryPolicy(policy *RetryPolicy) {
+	r.RetryPolicy = policy
+}
+
+// SetHttpClient sets the HTTP client used to send the request
+func (r *HttpRequestImpl) SetHttpClient(client *http.Client) {
+	r.HttpClient = client
+}
+
+// SetUserAgent sets the User-Agent header of the request
+func (r *HttpRequestImpl) SetUserAgent(userAgent string) {
+	r.UserAgent = userAgent
+}
+
+// SetLogger sets the logger for HTTP outbound log and enables the outbound log for the request
+func (r *HttpRequestImpl) SetLogger(logger *zerolog.Logger) {
+	r.Logger = logger
+	r.OutboundLog = logger != nil
+}
+
+// SetContentType sets the Content-Type header of the request, e.g. a multipart content type with its boundary
+func (r *HttpRequestImpl) SetContentType(contentType string) {
+	r.ContentType = contentType
+}
+
+// SetStreaming sets whether the body is streamed as is. A streamed body is read once,
+// so the request is never retried and the body is left out of the outbound log.
+func (r *HttpRequestImpl) SetStreaming(streaming bool) {
+	r.Streaming = streaming
+}
+
+// DoRequest sends the request using context.Background()
 func (r *HttpRequestImpl) DoRequest() *Error {
-	// NewRequest is used by Call to generate an http.Request.
-	req, err := http.NewRequest(r.Method, r.URL, r.Body)
+	return r.DoRequestContext(context.Background())
+}
+
+// DoRequestContext sends the request, cancellation and deadline of ctx are passed to the underlying HTTP client.
+// Failed attempts are retried according to the retry policy of the request.
+func (r *HttpRequestImpl) DoRequestContext(ctx context.Context) *Error {
+	// Get request body.
+	// The body is kept in memory, so it can be sent again on every attempt.
+	var reqBody []byte
+	if r.Body != nil && !r.Streaming {
+		var err error
+		if reqBody, err = io.ReadAll(r.Body); err != nil {
+			return &Error{
+				Message:  fmt.Sprintf("error request creation failed: %s", err.Error()),
+				RawError: err,
+			}
+		}
+		// Restore the io.Reader to its original state
+		r.Body = bytes.NewReader(reqBody)
+	}
+
+	policy := r.RetryPolicy
+	retryable := policy != nil && policy.MaxAttempts > 1 && policy.canRetry(ctx, r.Method) && !r.Streaming
+
+	var (
+		res     *http.Response
+		resBody []byte
+		e       *Error
+	)
+	for attempt := 1; ; attempt++ {
+		res, resBody, e = r.send(ctx, reqBody)
+		if !retryable || attempt >= policy.MaxAttempts || !policy.shouldRetry(ctx, res, rawErrorOf(e)) {
+			break
+		}
+
+		timer := time.NewTimer(policy.backoff(attempt, res))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return &Error{
+				Message:  fmt.Sprintf("error when request via http client, cannot send request with error: %s", ctx.Err().Error()),
+				RawError: ctx.Err(),
+			}
+		case <-timer.C:
+		}
+	}
+
+	if e != nil {
+		return e
+	}
+
+	rawResponse := newAPIResponse(res, resBody)
+
+	// Check StatusCode from Qiscus HTTP response api StatusCode
+	if res.StatusCode >= 400 {
+		// Error body may not match the response shape, decode what is possible
+		if r.Response != nil {
+			_ = json.Unmarshal(resBody, &r.Response)
+		}
+
+		e := &Error{
+			Message:        fmt.Sprintf("qiscus api is returning error. http status code: %s  api response: %s", strconv.Itoa(res.StatusCode), string(resBody)),
+			StatusCode:     res.StatusCode,
+			RawApiResponse: rawResponse,
+		}
+		e.decodeErrorBody(resBody)
+
+		return e
+	}
+
+	if r.Response != nil {
+		if err := json.Unmarshal(resBody, &r.Response); err != nil {
+			return &Error{
+				Message:        fmt.Sprintf("invalid body response, parse error during api request to qiscus with message: %s", err.Error()),
+				StatusCode:     res.StatusCode,
+				RawError:       err,
+				RawApiResponse: rawResponse,
+			}
+		}
+	}
+
+	return nil
+}
+
+// send performs a single attempt of the request and reads the response body
+func (r *HttpRequestImpl) send(ctx context.Context, reqBody []byte) (*http.Response, []byte, *Error) {
+	var body io.Reader
+	switch {
+	case r.Body != nil && r.Streaming:
+		body = r.Body
+	case r.Body != nil:
+		body = bytes.NewReader(reqBody)
+	}
+
+	// NewRequestWithContext is used by Call to generate an http.Request.
+	req, err := http.NewRequestWithContext(ctx, r.Method, r.URL, body)
 	if err != nil {
-		return &Error{
+		return nil, nil, &Error{
 			Message:  fmt.Sprintf("error request creation failed: %s", err.Error()),
 			RawError: err,
 		}
 	}
 
-	// Get request body.
-	// The request body after calling Do() function, will be closed by the underlying Transport, even on errors.
-	var reqBody []byte
-	if req.Body != nil {
-		reqBody, _ = io.ReadAll(req.Body)
-		// Restore the io.ReadCloser to its original state
-		req.Body = io.NopCloser(bytes.NewBuffer(reqBody))
-	}
-
 	// Set Parameters
 	if r.Parameters != nil && len(r.Parameters) > 0 {
 		params := req.URL.Query()
@@ -111,8 +278,16 @@ func (r *HttpRequestImpl) DoRequest() *Error {
 	}
 
 	// Set Headers
-	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("User-Agent", "Qiscus-Go/"+LibraryVersion)
+	contentType := r.ContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	req.Header.Add("Content-Type", contentType)
+	userAgent := r.UserAgent
+	if userAgent == "" {
+		userAgent = DefaultUserAgent
+	}
+	req.Header.Add("User-Agent", userAgent)
 
 	if r.Headers != nil && len(r.Headers) > 0 {
 		for header, value := range r.Headers {
@@ -123,10 +298,10 @@ func (r *HttpRequestImpl) DoRequest() *Error {
 	start := time.Now()
 	res, err := r.HttpClient.Do(req)
 	if err != nil {
-		return &Error{
-			Message:    fmt.Sprintf("error when request via http client, cannot send request with error: %s", err.Error()),
-			StatusCode: res.StatusCode,
-			RawError:   err,
+		// The response is nil when Do returns an error, e.g. when ctx is canceled or its deadline is exceeded
+		return nil, nil, &Error{
+			Message:  fmt.Sprintf("error when request via http client, cannot send request with error: %s", err.Error()),
+			RawError: err,
 		}
 	}
 
@@ -135,14 +310,14 @@ func (r *HttpRequestImpl) DoRequest() *Error {
 
 	resBody, err := ioutil.ReadAll(res.Body)
 	if err != nil {
-		return &Error{
+		return res, nil, &Error{
 			Message:    "cannot read response body: " + err.Error(),
 			StatusCode: res.StatusCode,
 			RawError:   err,
 		}
 	}
 
-	if DefaultHttpOutboundLog {
+	if r.OutboundLog {
 		compactBody := func(data []byte) string {
 			var js map[string]interface{}
 			if json.Unmarshal(data, &js) != nil {
@@ -156,8 +331,13 @@ func (r *HttpRequestImpl) DoRequest() *Error {
 			return result.String()
 		}
 
+		logger := r.Logger
+		if logger == nil {
+			logger = &log.Logger
+		}
+
 		// Write http outbound log
-		log.Info().
+		logger.Info().
 			Str("method", res.Request.Method).
 			Str("url", res.Request.URL.String()).
 			Str("body", compactBody(reqBody)).
@@ -167,28 +347,13 @@ func (r *HttpRequestImpl) DoRequest() *Error {
 			Msg("OUTBOUND LOG")
 	}
 
-	rawResponse := newAPIResponse(res, resBody)
-
-	if r.Response != nil {
-		if err = json.Unmarshal(resBody, &r.Response); err != nil {
-			return &Error{
-				Message:        fmt.Sprintf("invalid body response, parse error during api request to qiscus with message: %s", err.Error()),
-				StatusCode:     res.StatusCode,
-				RawError:       err,
-				RawApiResponse: rawResponse,
-			}
-		}
-	}
+	return res, resBody, nil
+}
 
-	// Check StatusCode from Qiscus HTTP response api StatusCode
-	if res.StatusCode >= 400 {
-		return &Error{
-			Message:        fmt.Sprintf("qiscus api is returning error. http status code: %s  api response: %s", strconv.Itoa(res.StatusCode), string(resBody)),
-			StatusCode:     res.StatusCode,
-			RawError:       err,
-			RawApiResponse: rawResponse,
-		}
+// rawErrorOf returns the raw error of e, or nil when e is nil
+func rawErrorOf(e *Error) error {
+	if e == nil {
+		return nil
 	}
-
-	return nil
+	return e.RawError
 }
@@ -0,0 +1,37 @@
+package qiscus
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// plainRequest implements HttpRequest only
+type plainRequest struct {
+	calls int
+}
+
+func (r *plainRequest) DoRequest() *Error {
+	r.calls++
+	return nil
+}
+
+func (r *plainRequest) AddHeader(name, value string) {}
+
+func (r *plainRequest) AddParameter(name, value string) {}
+
+func TestDoRequestContextFallback(t *testing.T) {
+	r := &plainRequest{}
+	assert.Nil(t, DoRequestContext(context.Background(), r))
+	assert.Equal(t, r.calls, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var err error = DoRequestContext(ctx, r)
+	assert.True(t, errors.Is(err, ErrTransport))
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, r.calls, 1)
+}
@@ -0,0 +1,481 @@
+// Command fakegen generates a function-field based fake of an interface, recording every call.
+//
+// It is used with go:generate to keep sdktest.FakeSDK and multichanneltest.FakeMultichannel
+// in sync with sdk.SDK and multichannel.Multichannel:
+//
+//	//go:generate go run ../../internal/cmd/fakegen -dir .. -iface SDK -name FakeSDK -out fake.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	var cfg config
+	flag.StringVar(&cfg.dir, "dir", "", "directory of the package declaring the interface")
+	flag.StringVar(&cfg.iface, "iface", "", "name of the interface")
+	flag.StringVar(&cfg.name, "name", "", "name of the generated fake type")
+	flag.StringVar(&cfg.pkg, "pkg", "", "package name of the generated file, default the current directory name")
+	out := flag.String("out", "", "output file, default stdout")
+	flag.Parse()
+
+	if cfg.dir == "" || cfg.iface == "" || cfg.name == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if cfg.pkg == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			log.Fatal(err)
+		}
+		cfg.pkg = filepath.Base(wd)
+	}
+
+	src, err := generate(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *out == "" {
+		os.Stdout.Write(src)
+		return
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type config struct {
+	dir   string
+	iface string
+	name  string
+	pkg   string
+}
+
+type method struct {
+	name    string
+	params  []param
+	results []string
+}
+
+type param struct {
+	name string
+	typ  string
+}
+
+// generator renders types of the source package, qualified for the generated package
+type generator struct {
+	srcPkg   string
+	srcPath  string
+	local    map[string]bool   // exported types declared in the source package
+	imports  map[string]string // import name to path in the file declaring the interface
+	used     map[string]string // import name to path used by the generated code
+	builtins map[string]bool
+}
+
+func generate(cfg config) ([]byte, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, cfg.dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected a single package in %s, found %d", cfg.dir, len(pkgs))
+	}
+
+	var pkg *ast.Package
+	for _, p := range pkgs {
+		pkg = p
+	}
+
+	g := &generator{
+		srcPkg: pkg.Name,
+		local:  make(map[string]bool),
+		used:   make(map[string]string),
+	}
+
+	var iface *ast.InterfaceType
+	for _, f := range pkg.Files {
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				g.local[ts.Name.Name] = ts.Name.IsExported()
+				if it, ok := ts.Type.(*ast.InterfaceType); ok && ts.Name.Name == cfg.iface {
+					iface = it
+					g.imports = fileImports(f)
+				}
+			}
+		}
+	}
+	if iface == nil {
+		return nil, fmt.Errorf("interface %s not found in %s", cfg.iface, cfg.dir)
+	}
+
+	srcPath, err := importPath(cfg.dir)
+	if err != nil {
+		return nil, err
+	}
+	g.srcPath = srcPath
+	g.used[g.srcPkg] = srcPath
+	g.used["context"] = "context"
+	g.used["sync"] = "sync"
+
+	var methods []method
+	for _, field := range iface.Methods.List {
+		ft, ok := field.Type.(*ast.FuncType)
+		if !ok {
+			return nil, fmt.Errorf("embedded interfaces are not supported in %s", cfg.iface)
+		}
+		methods = append(methods, g.method(field.Names[0].Name, ft))
+	}
+
+	return g.render(cfg, methods)
+}
+
+func (g *generator) method(name string, ft *ast.FuncType) method {
+	m := method{name: name}
+
+	i := 0
+	for _, field := range ft.Params.List {
+		typ := g.typeString(field.Type)
+		if len(field.Names) == 0 {
+			m.params = append(m.params, param{name: "p" + strconv.Itoa(i), typ: typ})
+			i++
+			continue
+		}
+		for _, n := range field.Names {
+			m.params = append(m.params, param{name: n.Name, typ: typ})
+			i++
+		}
+	}
+
+	if ft.Results != nil {
+		for _, field := range ft.Results.List {
+			typ := g.typeString(field.Type)
+			n := len(field.Names)
+			if n == 0 {
+				n = 1
+			}
+			for j := 0; j < n; j++ {
+				m.results = append(m.results, typ)
+			}
+		}
+	}
+
+	return m
+}
+
+// typeString renders expr, qualifying the types of the source package
+func (g *generator) typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if g.local[t.Name] {
+			return g.srcPkg + "." + t.Name
+		}
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + g.typeString(t.X)
+	case *ast.SelectorExpr:
+		pkg := t.X.(*ast.Ident).Name
+		g.used[pkg] = g.imports[pkg]
+		return pkg + "." + t.Sel.Name
+	case *ast.ArrayType:
+		if t.Len == nil {
+			return "[]" + g.typeString(t.Elt)
+		}
+		return "[" + t.Len.(*ast.BasicLit).Value + "]" + g.typeString(t.Elt)
+	case *ast.MapType:
+		return "map[" + g.typeString(t.Key) + "]" + g.typeString(t.Value)
+	case *ast.Ellipsis:
+		return "..." + g.typeString(t.Elt)
+	case *ast.InterfaceType:
+		return "interface{}"
+	case *ast.ChanType:
+		return "chan " + g.typeString(t.Value)
+	case *ast.FuncType:
+		m := g.method("", t)
+		var params []string
+		for _, p := range m.params {
+			params = append(params, p.typ)
+		}
+		return "func(" + strings.Join(params, ", ") + ")" + resultList(m.results)
+	}
+	panic(fmt.Sprintf("fakegen: unsupported type %T", expr))
+}
+
+// zero returns the value returned when a method is not faked.
+// Pointers to structs of the source package are returned empty, not nil, as the real client does.
+func (g *generator) zero(typ string) string {
+	switch {
+	case strings.HasPrefix(typ, "*"+g.srcPkg+"."):
+		return "&" + typ[1:] + "{}"
+	case typ == "string":
+		return `""`
+	case typ == "bool":
+		return "false"
+	case strings.HasPrefix(typ, "int") || strings.HasPrefix(typ, "uint") || strings.HasPrefix(typ, "float"):
+		return "0"
+	case strings.HasPrefix(typ, "*") || strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "map[") ||
+		strings.HasPrefix(typ, "func(") || strings.HasPrefix(typ, "chan ") || typ == "error" || typ == "interface{}":
+		return "nil"
+	}
+	return "*new(" + typ + ")"
+}
+
+func (g *generator) render(cfg config, methods []method) ([]byte, error) {
+	byName := make(map[string]method, len(methods))
+	for _, m := range methods {
+		byName[m.name] = m
+	}
+
+	var b bytes.Buffer
+	p := func(format string, args ...interface{}) { fmt.Fprintf(&b, format, args...) }
+
+	p("// Code generated by fakegen. DO NOT EDIT.\n\n")
+	p("package %s\n\n", cfg.pkg)
+
+	var names []string
+	for name := range g.used {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return g.used[names[i]] < g.used[names[j]] })
+	// Standard library first, then the other packages
+	p("import (\n")
+	for _, std := range []bool{true, false} {
+		for _, name := range names {
+			path := g.used[name]
+			if isStd(path) != std {
+				continue
+			}
+			if filepath.Base(path) == name || (name == "qiscus" && strings.HasSuffix(path, "/qiscus-go")) {
+				p("\t%q\n", path)
+			} else {
+				p("\t%s %q\n", name, path)
+			}
+		}
+		if std {
+			p("\n")
+		}
+	}
+	p(")\n\n")
+
+	p("// Call is a call recorded by %s\n", cfg.name)
+	p("type Call struct {\n\tMethod string\n\tArgs   []interface{}\n}\n\n")
+
+	p("// %s is a fake %s.%s for unit tests.\n", cfg.name, g.srcPkg, cfg.iface)
+	p("// Every method records its call and calls the matching Func field when set.\n")
+	p("// A method without Func falls back to its Context variant Func, otherwise it returns empty results.\n")
+	p("type %s struct {\n", cfg.name)
+	p("\tmu    sync.Mutex\n\tcalls []Call\n\n")
+	for _, m := range methods {
+		p("\t%sFunc func(%s)%s\n", m.name, paramTypes(m.params), resultList(m.results))
+	}
+	p("}\n\n")
+
+	p("var _ %s.%s = (*%s)(nil)\n\n", g.srcPkg, cfg.iface, cfg.name)
+
+	recv := strings.ToLower(cfg.name[:1])
+	p("// Calls returns the recorded calls in order\n")
+	p("func (%s *%s) Calls() []Call {\n", recv, cfg.name)
+	p("\t%s.mu.Lock()\n\tdefer %s.mu.Unlock()\n\n", recv, recv)
+	p("\treturn append([]Call(nil), %s.calls...)\n}\n\n", recv)
+
+	p("// CallsTo returns the recorded calls of a method in order\n")
+	p("func (%s *%s) CallsTo(method string) []Call {\n", recv, cfg.name)
+	p("\t%s.mu.Lock()\n\tdefer %s.mu.Unlock()\n\n", recv, recv)
+	p("\tvar calls []Call\n\tfor _, c := range %s.calls {\n\t\tif c.Method == method {\n\t\t\tcalls = append(calls, c)\n\t\t}\n\t}\n\treturn calls\n}\n\n", recv)
+
+	p("// Reset clears the recorded calls\n")
+	p("func (%s *%s) Reset() {\n", recv, cfg.name)
+	p("\t%s.mu.Lock()\n\tdefer %s.mu.Unlock()\n\n", recv, recv)
+	p("\t%s.calls = nil\n}\n\n", recv)
+
+	p("func (%s *%s) record(method string, args ...interface{}) {\n", recv, cfg.name)
+	p("\t%s.mu.Lock()\n\tdefer %s.mu.Unlock()\n\n", recv, recv)
+	p("\t%s.calls = append(%s.calls, Call{Method: method, Args: args})\n}\n", recv, recv)
+
+	for _, m := range methods {
+		args := paramNames(m.params)
+		ret := ""
+		if len(m.results) > 0 {
+			ret = "return "
+		}
+
+		p("\n// %s calls %sFunc\n", m.name, m.name)
+		p("func (%s *%s) %s(%s)%s {\n", recv, cfg.name, m.name, paramList(m.params), resultList(m.results))
+		p("\t%s.record(%q%s)\n", recv, m.name, prefixComma(recordedNames(m.params)))
+		p("\tif %s.%sFunc != nil {\n\t\t%s%s.%sFunc(%s)\n", recv, m.name, ret, recv, m.name, args)
+		if ret == "" {
+			p("\t}\n}\n")
+			continue
+		}
+		p("\t}\n")
+
+		// Fall back to the Context variant
+		if c, ok := byName[m.name+"Context"]; ok && len(c.params) == len(m.params)+1 {
+			p("\tif %s.%sFunc != nil {\n\t\treturn %s.%sFunc(%s)\n\t}\n", recv, c.name, recv, c.name, "context.Background()"+prefixComma(args))
+		}
+
+		var zeros []string
+		for _, r := range m.results {
+			zeros = append(zeros, g.zero(r))
+		}
+		p("\treturn %s\n}\n", strings.Join(zeros, ", "))
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %v\n%s", err, b.String())
+	}
+
+	// Drop imports only needed by unused fallbacks
+	return removeUnusedImports(src)
+}
+
+func removeUnusedImports(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[string]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok {
+				used[id.Name] = true
+			}
+		}
+		return true
+	})
+
+	for _, imp := range f.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		name := filepath.Base(path)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		} else if strings.HasSuffix(path, "/qiscus-go") {
+			name = "qiscus"
+		}
+		if !used[name] {
+			src = bytes.Replace(src, []byte("\t"+imp.Path.Value+"\n"), nil, 1)
+		}
+	}
+
+	return format.Source(src)
+}
+
+func fileImports(f *ast.File) map[string]string {
+	imports := make(map[string]string)
+	for _, imp := range f.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		name := filepath.Base(path)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		} else if strings.HasSuffix(path, "/qiscus-go") {
+			name = "qiscus"
+		}
+		imports[name] = path
+	}
+	return imports
+}
+
+// importPath returns the import path of dir, from the enclosing go.mod
+func importPath(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+
+	for root := abs; ; root = filepath.Dir(root) {
+		data, err := ioutil.ReadFile(filepath.Join(root, "go.mod"))
+		if err == nil {
+			for _, line := range strings.Split(string(data), "\n") {
+				if strings.HasPrefix(line, "module ") {
+					rel, _ := filepath.Rel(root, abs)
+					return filepath.ToSlash(filepath.Join(strings.TrimSpace(strings.TrimPrefix(line, "module ")), rel)), nil
+				}
+			}
+		}
+		if filepath.Dir(root) == root {
+			return "", fmt.Errorf("go.mod not found for %s", dir)
+		}
+	}
+}
+
+func isStd(path string) bool {
+	return !strings.Contains(strings.SplitN(path, "/", 2)[0], ".")
+}
+
+func paramList(params []param) string {
+	var list []string
+	for _, p := range params {
+		list = append(list, p.name+" "+p.typ)
+	}
+	return strings.Join(list, ", ")
+}
+
+func paramTypes(params []param) string {
+	var list []string
+	for _, p := range params {
+		list = append(list, p.typ)
+	}
+	return strings.Join(list, ", ")
+}
+
+func paramNames(params []param) string {
+	var list []string
+	for _, p := range params {
+		name := p.name
+		if strings.HasPrefix(p.typ, "...") {
+			name += "..."
+		}
+		list = append(list, name)
+	}
+	return strings.Join(list, ", ")
+}
+
+// recordedNames returns the arguments of a recorded call, a variadic parameter is recorded as one slice
+func recordedNames(params []param) string {
+	var list []string
+	for _, p := range params {
+		list = append(list, p.name)
+	}
+	return strings.Join(list, ", ")
+}
+
+func resultList(results []string) string {
+	switch len(results) {
+	case 0:
+		return ""
+	case 1:
+		return " " + results[0]
+	}
+	return " (" + strings.Join(results, ", ") + ")"
+}
+
+func prefixComma(s string) string {
+	if s == "" {
+		return ""
+	}
+	return ", " + s
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGeneratedFakesUpToDate fails when an interface changed without running go generate
+func TestGeneratedFakesUpToDate(t *testing.T) {
+	tests := []struct {
+		cfg config
+		out string
+	}{
+		{config{dir: "../../../sdk", iface: "SDK", name: "FakeSDK", pkg: "sdktest"}, "../../../sdk/sdktest/fake.go"},
+		{config{dir: "../../../multichannel", iface: "Multichannel", name: "FakeMultichannel", pkg: "multichanneltest"}, "../../../multichannel/multichanneltest/fake.go"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cfg.name, func(t *testing.T) {
+			want, err := generate(tt.cfg)
+			assert.Nil(t, err)
+
+			got, err := ioutil.ReadFile(filepath.FromSlash(tt.out))
+			assert.Nil(t, err)
+			assert.Equal(t, string(want), string(got), "%s is out of date, run go generate ./...", tt.out)
+		})
+	}
+}
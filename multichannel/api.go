@@ -2,78 +2,97 @@ package multichannel
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
 
 	"github.com/Qiscus-Integration/qiscus-go"
+	"github.com/Qiscus-Integration/qiscus-go/sdk"
 )
 
 // GetRoomTags get room tags by room ID
 func (m *MultichannelImpl) GetRoomTags(roomID string) (*RoomTagsResponse, *qiscus.Error) {
+	return m.GetRoomTagsContext(context.Background(), roomID)
+}
+
+// GetRoomTagsContext get room tags by room ID with context
+func (m *MultichannelImpl) GetRoomTagsContext(ctx context.Context, roomID string) (*RoomTagsResponse, *qiscus.Error) {
 	resp := &RoomTagsResponse{}
 	url := fmt.Sprintf("%s/api/v1/room_tag/%s", m.APIBase(), roomID)
 
-	r := qiscus.NewHttpRequest(http.MethodGet, url, nil, resp)
-	r.AddHeader("Qiscus-App-Id", m.QiscusAppID())
-	r.AddHeader("Qiscus-Secret-Key", m.QiscusSecretKey())
+	r := m.newRequest(http.MethodGet, url, nil, resp)
 
-	err := r.DoRequest()
+	err := m.do(ctx, r)
 
 	return resp, err
 }
 
 // CreateRoomTag create room tag
 func (m *MultichannelImpl) CreateRoomTag(req *CreateRoomTagReq) (*CreateRoomTagResponse, *qiscus.Error) {
+	return m.CreateRoomTagContext(context.Background(), req)
+}
+
+// CreateRoomTagContext create room tag with context
+func (m *MultichannelImpl) CreateRoomTagContext(ctx context.Context, req *CreateRoomTagReq) (*CreateRoomTagResponse, *qiscus.Error) {
 	resp := &CreateRoomTagResponse{}
 	url := fmt.Sprintf("%s/api/v1/room_tag/create", m.APIBase())
 	jsonReq, _ := json.Marshal(req)
 
-	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
-	r.AddHeader("Qiscus-App-Id", m.QiscusAppID())
-	r.AddHeader("Qiscus-Secret-Key", m.QiscusSecretKey())
+	r := m.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
 
-	err := r.DoRequest()
+	err := m.do(ctx, r)
 
 	return resp, err
 }
 
 // CreateAdditionalInfoRoomWithReplace create additional info room with replace exisiting data
 func (m *MultichannelImpl) CreateAdditionalInfoRoomWithReplace(roomID string, req *CreateAdditionalInfoRoomReq) (*CreateAdditionalInfoRoomResponse, *qiscus.Error) {
+	return m.CreateAdditionalInfoRoomWithReplaceContext(context.Background(), roomID, req)
+}
+
+// CreateAdditionalInfoRoomWithReplaceContext create additional info room with replace exisiting data with context
+func (m *MultichannelImpl) CreateAdditionalInfoRoomWithReplaceContext(ctx context.Context, roomID string, req *CreateAdditionalInfoRoomReq) (*CreateAdditionalInfoRoomResponse, *qiscus.Error) {
 	resp := &CreateAdditionalInfoRoomResponse{}
 	url := fmt.Sprintf("%s/api/v1/qiscus/room/%s/user_info", m.APIBase(), roomID)
 
 	jsonReq, _ := json.Marshal(req)
 
-	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
-	r.AddHeader("Qiscus-App-Id", m.QiscusAppID())
-	r.AddHeader("Qiscus-Secret-Key", m.QiscusSecretKey())
+	r := m.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
 
-	err := r.DoRequest()
+	err := m.do(ctx, r)
 
 	return resp, err
 }
 
 // GetAdditionalInfoRoom get additional info room by room ID
 func (m *MultichannelImpl) GetAdditionalInfoRoom(roomID string) (*GetAdditionalInfoRoomResponse, *qiscus.Error) {
+	return m.GetAdditionalInfoRoomContext(context.Background(), roomID)
+}
+
+// GetAdditionalInfoRoomContext get additional info room by room ID with context
+func (m *MultichannelImpl) GetAdditionalInfoRoomContext(ctx context.Context, roomID string) (*GetAdditionalInfoRoomResponse, *qiscus.Error) {
 	resp := &GetAdditionalInfoRoomResponse{}
 	url := fmt.Sprintf("%s/api/v1/qiscus/room/%s/user_info", m.APIBase(), roomID)
 
-	r := qiscus.NewHttpRequest(http.MethodGet, url, nil, resp)
-	r.AddHeader("Qiscus-App-Id", m.QiscusAppID())
-	r.AddHeader("Qiscus-Secret-Key", m.QiscusSecretKey())
+	r := m.newRequest(http.MethodGet, url, nil, resp)
 
-	err := r.DoRequest()
+	err := m.do(ctx, r)
 
 	return resp, err
 }
 
 // CreateAdditionalInfoRoom create additional info room without replace existing data
 func (m *MultichannelImpl) CreateAdditionalInfoRoom(roomID string, req *CreateAdditionalInfoRoomReq) (*CreateAdditionalInfoRoomResponse, *qiscus.Error) {
+	return m.CreateAdditionalInfoRoomContext(context.Background(), roomID, req)
+}
+
+// CreateAdditionalInfoRoomContext create additional info room without replace existing data with context
+func (m *MultichannelImpl) CreateAdditionalInfoRoomContext(ctx context.Context, roomID string, req *CreateAdditionalInfoRoomReq) (*CreateAdditionalInfoRoomResponse, *qiscus.Error) {
 	resp := &CreateAdditionalInfoRoomResponse{}
 
-	res, e := m.GetAdditionalInfoRoom(roomID)
+	res, e := m.GetAdditionalInfoRoomContext(ctx, roomID)
 	if e != nil {
 		return resp, e
 	}
@@ -96,17 +115,20 @@ func (m *MultichannelImpl) CreateAdditionalInfoRoom(roomID string, req *CreateAd
 	url := fmt.Sprintf("%s/api/v1/qiscus/room/%s/user_info", m.APIBase(), roomID)
 	jsonReq, _ := json.Marshal(req)
 
-	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
-	r.AddHeader("Qiscus-App-Id", m.QiscusAppID())
-	r.AddHeader("Qiscus-Secret-Key", m.QiscusSecretKey())
+	r := m.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
 
-	err := r.DoRequest()
+	err := m.do(ctx, r)
 
 	return resp, err
 }
 
 // SendMessageTextByBot send message text by bot
 func (m *MultichannelImpl) SendMessageTextByBot(req *SendMessageTextByBotReq) *qiscus.Error {
+	return m.SendMessageTextByBotContext(context.Background(), req)
+}
+
+// SendMessageTextByBotContext send message text by bot with context
+func (m *MultichannelImpl) SendMessageTextByBotContext(ctx context.Context, req *SendMessageTextByBotReq) *qiscus.Error {
 	url := fmt.Sprintf("%s/%s/bot", m.APIBase(), m.QiscusAppID())
 
 	newReq := struct {
@@ -123,34 +145,63 @@ func (m *MultichannelImpl) SendMessageTextByBot(req *SendMessageTextByBotReq) *q
 
 	jsonReq, _ := json.Marshal(newReq)
 
-	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), nil)
-	r.AddHeader("Qiscus-App-Id", m.QiscusAppID())
-	r.AddHeader("Qiscus-Secret-Key", m.QiscusSecretKey())
+	r := m.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), nil)
 
-	err := r.DoRequest()
+	err := m.do(ctx, r)
 
 	return err
 }
 
+// SendMessageByBot send message by bot, with any comment type and payload
+func (m *MultichannelImpl) SendMessageByBot(req *SendMessageByBotReq) (*SendMessageByBotResponse, *qiscus.Error) {
+	return m.SendMessageByBotContext(context.Background(), req)
+}
+
+// SendMessageByBotContext send message by bot, with any comment type and payload with context
+func (m *MultichannelImpl) SendMessageByBotContext(ctx context.Context, req *SendMessageByBotReq) (*SendMessageByBotResponse, *qiscus.Error) {
+	resp := &SendMessageByBotResponse{}
+	url := fmt.Sprintf("%s/%s/bot", m.APIBase(), m.QiscusAppID())
+
+	if req.Type == "" {
+		req.Type = sdk.CommentTypeText
+	}
+
+	jsonReq, _ := json.Marshal(req)
+
+	r := m.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
+
+	err := m.do(ctx, r)
+
+	return resp, err
+}
+
 // SetToggleBotInRoom set tootle bot in room
 func (m *MultichannelImpl) SetToggleBotInRoom(roomID string, isActive bool) (*SetToggleBotInRoomResponse, *qiscus.Error) {
+	return m.SetToggleBotInRoomContext(context.Background(), roomID, isActive)
+}
+
+// SetToggleBotInRoomContext set tootle bot in room with context
+func (m *MultichannelImpl) SetToggleBotInRoomContext(ctx context.Context, roomID string, isActive bool) (*SetToggleBotInRoomResponse, *qiscus.Error) {
 	resp := &SetToggleBotInRoomResponse{}
 	url := fmt.Sprintf("%s/bot/%s/activate", m.APIBase(), roomID)
 
 	req := SetToggleBotInRoomReq{IsActive: isActive}
 	jsonReq, _ := json.Marshal(req)
 
-	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
-	r.AddHeader("Qiscus-App-Id", m.QiscusAppID())
-	r.AddHeader("Qiscus-Secret-Key", m.QiscusSecretKey())
+	r := m.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
 
-	err := r.DoRequest()
+	err := m.do(ctx, r)
 
 	return resp, err
 }
 
 // GetAllAgents get all agent with scope search included
 func (m *MultichannelImpl) GetAllAgents(req *GetAllAgentsReq) (*GetAllAgentsResponse, *qiscus.Error) {
+	return m.GetAllAgentsContext(context.Background(), req)
+}
+
+// GetAllAgentsContext get all agent with scope search included with context
+func (m *MultichannelImpl) GetAllAgentsContext(ctx context.Context, req *GetAllAgentsReq) (*GetAllAgentsResponse, *qiscus.Error) {
 	resp := &GetAllAgentsResponse{}
 	url := fmt.Sprintf("%s/api/v2/admin/agents", m.APIBase())
 
@@ -164,22 +215,25 @@ func (m *MultichannelImpl) GetAllAgents(req *GetAllAgentsReq) (*GetAllAgentsResp
 		req.Limit = 20
 	}
 
-	r := qiscus.NewHttpRequest(http.MethodGet, url, nil, resp)
-	r.AddHeader("Qiscus-App-Id", m.QiscusAppID())
-	r.AddHeader("Qiscus-Secret-Key", m.QiscusSecretKey())
+	r := m.newRequest(http.MethodGet, url, nil, resp)
 
 	r.AddParameter("page", strconv.Itoa(req.Page))
 	r.AddParameter("limit", strconv.Itoa(req.Limit))
 	r.AddParameter("search", req.Search)
 	r.AddParameter("scope", req.Scope)
 
-	err := r.DoRequest()
+	err := m.do(ctx, r)
 
 	return resp, err
 }
 
 // AssignAgent assign agent
 func (m *MultichannelImpl) AssignAgent(req *AssignAgentReq) (*AssignAgentResponse, *qiscus.Error) {
+	return m.AssignAgentContext(context.Background(), req)
+}
+
+// AssignAgentContext assign agent with context
+func (m *MultichannelImpl) AssignAgentContext(ctx context.Context, req *AssignAgentReq) (*AssignAgentResponse, *qiscus.Error) {
 	resp := &AssignAgentResponse{}
 	url := fmt.Sprintf("%s/api/v1/admin/service/assign_agent", m.APIBase())
 
@@ -190,17 +244,20 @@ func (m *MultichannelImpl) AssignAgent(req *AssignAgentReq) (*AssignAgentRespons
 
 	jsonReq, _ := json.Marshal(req)
 
-	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
-	r.AddHeader("Qiscus-App-Id", m.QiscusAppID())
-	r.AddHeader("Qiscus-Secret-Key", m.QiscusSecretKey())
+	r := m.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
 
-	err := r.DoRequest()
+	err := m.do(ctx, r)
 
 	return resp, err
 }
 
 // GetAgentsByDivision get agents by division
 func (m *MultichannelImpl) GetAgentsByDivision(req *GetAgentsByDivisionReq) (*GetAgentsByDivisionResponse, *qiscus.Error) {
+	return m.GetAgentsByDivisionContext(context.Background(), req)
+}
+
+// GetAgentsByDivisionContext get agents by division with context
+func (m *MultichannelImpl) GetAgentsByDivisionContext(ctx context.Context, req *GetAgentsByDivisionReq) (*GetAgentsByDivisionResponse, *qiscus.Error) {
 	resp := &GetAgentsByDivisionResponse{}
 	url := fmt.Sprintf("%s/api/v2/admin/agents/by_division", m.APIBase())
 
@@ -214,9 +271,7 @@ func (m *MultichannelImpl) GetAgentsByDivision(req *GetAgentsByDivisionReq) (*Ge
 		req.Limit = 20
 	}
 
-	r := qiscus.NewHttpRequest(http.MethodGet, url, nil, resp)
-	r.AddHeader("Qiscus-App-Id", m.QiscusAppID())
-	r.AddHeader("Qiscus-Secret-Key", m.QiscusSecretKey())
+	r := m.newRequest(http.MethodGet, url, nil, resp)
 
 	r.AddParameter("page", strconv.Itoa(req.Page))
 	r.AddParameter("limit", strconv.Itoa(req.Limit))
@@ -226,13 +281,18 @@ func (m *MultichannelImpl) GetAgentsByDivision(req *GetAgentsByDivisionReq) (*Ge
 	for _, divisionID := range req.DivisionIDs {
 		r.AddParameter("division_ids[]", divisionID)
 	}
-	err := r.DoRequest()
+	err := m.do(ctx, r)
 
 	return resp, err
 }
 
 // GetAllDivision get all division
 func (m *MultichannelImpl) GetAllDivision(req *GetAllDivisionReq) (*GetAllDivisionResponse, *qiscus.Error) {
+	return m.GetAllDivisionContext(context.Background(), req)
+}
+
+// GetAllDivisionContext get all division with context
+func (m *MultichannelImpl) GetAllDivisionContext(ctx context.Context, req *GetAllDivisionReq) (*GetAllDivisionResponse, *qiscus.Error) {
 	resp := &GetAllDivisionResponse{}
 	url := fmt.Sprintf("%s/api/v2/divisions", m.APIBase())
 
@@ -246,56 +306,220 @@ func (m *MultichannelImpl) GetAllDivision(req *GetAllDivisionReq) (*GetAllDivisi
 		req.Limit = 20
 	}
 
-	r := qiscus.NewHttpRequest(http.MethodGet, url, nil, resp)
-	r.AddHeader("Qiscus-App-Id", m.QiscusAppID())
-	r.AddHeader("Qiscus-Secret-Key", m.QiscusSecretKey())
+	r := m.newRequest(http.MethodGet, url, nil, resp)
 
 	r.AddParameter("page", strconv.Itoa(req.Page))
 	r.AddParameter("limit", strconv.Itoa(req.Limit))
-	err := r.DoRequest()
+	err := m.do(ctx, r)
 
 	return resp, err
 }
 
 // MarkAsResolved mark as resolved room
 func (m *MultichannelImpl) MarkAsResolved(req *MarkAsResolvedReq) (*MarkAsResolvedResponse, *qiscus.Error) {
+	return m.MarkAsResolvedContext(context.Background(), req)
+}
+
+// MarkAsResolvedContext mark as resolved room with context
+func (m *MultichannelImpl) MarkAsResolvedContext(ctx context.Context, req *MarkAsResolvedReq) (*MarkAsResolvedResponse, *qiscus.Error) {
 	resp := &MarkAsResolvedResponse{}
 	url := fmt.Sprintf("%s/api/v1/admin/service/mark_as_resolved", m.APIBase())
 	jsonReq, _ := json.Marshal(req)
 
-	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
-	r.AddHeader("Qiscus-App-Id", m.QiscusAppID())
-	r.AddHeader("Qiscus-Secret-Key", m.QiscusSecretKey())
+	r := m.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
 
-	err := r.DoRequest()
+	err := m.do(ctx, r)
 
 	return resp, err
 }
 
 // GetAllChannels get all channels
 func (m *MultichannelImpl) GetAllChannels() (*GetAllChannelsResponse, *qiscus.Error) {
+	return m.GetAllChannelsContext(context.Background())
+}
+
+// GetAllChannelsContext get all channels with context
+func (m *MultichannelImpl) GetAllChannelsContext(ctx context.Context) (*GetAllChannelsResponse, *qiscus.Error) {
 	resp := &GetAllChannelsResponse{}
 	url := fmt.Sprintf("%s/api/v2/channels", m.APIBase())
 
-	r := qiscus.NewHttpRequest(http.MethodGet, url, nil, resp)
-	r.AddHeader("Qiscus-App-Id", m.QiscusAppID())
-	r.AddHeader("Qiscus-Secret-Key", m.QiscusSecretKey())
+	r := m.newRequest(http.MethodGet, url, nil, resp)
 
-	err := r.DoRequest()
+	err := m.do(ctx, r)
 
 	return resp, err
 }
 
 // GetRoomByRoomID get room by room id
 func (m *MultichannelImpl) GetRoomByRoomID(roomID string) (*GetRoomByRoomIDResponse, *qiscus.Error) {
+	return m.GetRoomByRoomIDContext(context.Background(), roomID)
+}
+
+// GetRoomByRoomIDContext get room by room id with context
+func (m *MultichannelImpl) GetRoomByRoomIDContext(ctx context.Context, roomID string) (*GetRoomByRoomIDResponse, *qiscus.Error) {
 	resp := &GetRoomByRoomIDResponse{}
 	url := fmt.Sprintf("%s/api/v2/customer_rooms/%s", m.APIBase(), roomID)
 
-	r := qiscus.NewHttpRequest(http.MethodGet, url, nil, resp)
-	r.AddHeader("Qiscus-App-Id", m.QiscusAppID())
-	r.AddHeader("Qiscus-Secret-Key", m.QiscusSecretKey())
+	r := m.newRequest(http.MethodGet, url, nil, resp)
+
+	err := m.do(ctx, r)
+
+	return resp, err
+}
+
+// GetCustomerRooms get customer rooms matching the filters, newest first
+func (m *MultichannelImpl) GetCustomerRooms(req *GetCustomerRoomsReq) (*GetCustomerRoomsResponse, *qiscus.Error) {
+	return m.GetCustomerRoomsContext(context.Background(), req)
+}
+
+// GetCustomerRoomsContext get customer rooms matching the filters, newest first with context
+func (m *MultichannelImpl) GetCustomerRoomsContext(ctx context.Context, req *GetCustomerRoomsReq) (*GetCustomerRoomsResponse, *qiscus.Error) {
+	resp := &GetCustomerRoomsResponse{}
+	url := fmt.Sprintf("%s/api/v2/customer_rooms", m.APIBase())
+
+	// Set default limit
+	if req.Limit <= 0 {
+		req.Limit = 50
+	}
+
+	jsonReq, _ := json.Marshal(req)
+
+	r := m.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
+
+	err := m.do(ctx, r)
+
+	return resp, err
+}
+
+// GetHSMTemplates get WhatsApp HSM templates
+func (m *MultichannelImpl) GetHSMTemplates(req *GetHSMTemplatesReq) (*GetHSMTemplatesResponse, *qiscus.Error) {
+	return m.GetHSMTemplatesContext(context.Background(), req)
+}
+
+// GetHSMTemplatesContext get WhatsApp HSM templates with context
+func (m *MultichannelImpl) GetHSMTemplatesContext(ctx context.Context, req *GetHSMTemplatesReq) (*GetHSMTemplatesResponse, *qiscus.Error) {
+	resp := &GetHSMTemplatesResponse{}
+	url := fmt.Sprintf("%s/api/v2/admin/hsm", m.APIBase())
+
+	// Set default page
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	// Set default limit
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+
+	r := m.newRequest(http.MethodGet, url, nil, resp)
+
+	r.AddParameter("page", strconv.Itoa(req.Page))
+	r.AddParameter("limit", strconv.Itoa(req.Limit))
+	if req.ChannelID > 0 {
+		r.AddParameter("channel_id", strconv.Itoa(req.ChannelID))
+	}
+	if req.Approved {
+		r.AddParameter("approved", "true")
+	}
+
+	err := m.do(ctx, r)
+
+	return resp, err
+}
+
+// SendHSM send a WhatsApp HSM template message
+func (m *MultichannelImpl) SendHSM(req *SendHSMReq) (*SendHSMResponse, *qiscus.Error) {
+	return m.SendHSMContext(context.Background(), req)
+}
+
+// SendHSMContext send a WhatsApp HSM template message with context
+func (m *MultichannelImpl) SendHSMContext(ctx context.Context, req *SendHSMReq) (*SendHSMResponse, *qiscus.Error) {
+	resp := &SendHSMResponse{}
+	url := fmt.Sprintf("%s/whatsapp/v1/%s/%d/messages", m.APIBase(), m.QiscusAppID(), req.ChannelID)
+
+	// Set default type
+	if req.Type == "" {
+		req.Type = "template"
+	}
+
+	jsonReq, _ := json.Marshal(req)
+
+	r := m.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
+
+	err := m.do(ctx, r)
+
+	return resp, err
+}
+
+// CreateBroadcastJob submit a WhatsApp HSM broadcast job to the recipients
+func (m *MultichannelImpl) CreateBroadcastJob(req *CreateBroadcastJobReq) (*CreateBroadcastJobResponse, *qiscus.Error) {
+	return m.CreateBroadcastJobContext(context.Background(), req)
+}
+
+// CreateBroadcastJobContext submit a WhatsApp HSM broadcast job to the recipients with context
+func (m *MultichannelImpl) CreateBroadcastJobContext(ctx context.Context, req *CreateBroadcastJobReq) (*CreateBroadcastJobResponse, *qiscus.Error) {
+	resp := &CreateBroadcastJobResponse{}
+	url := fmt.Sprintf("%s/api/v3/admin/broadcast/client", m.APIBase())
+
+	if len(req.Recipients) == 0 {
+		return resp, &qiscus.Error{
+			Message:     "create broadcast job failed. recipients is empty",
+			FieldErrors: map[string][]string{"recipients": {"recipients is empty"}},
+		}
+	}
+
+	jsonReq, _ := json.Marshal(req)
+
+	r := m.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
+
+	err := m.do(ctx, r)
+
+	return resp, err
+}
+
+// GetBroadcastJob get broadcast job status by broadcast job ID
+func (m *MultichannelImpl) GetBroadcastJob(broadcastJobID int) (*GetBroadcastJobResponse, *qiscus.Error) {
+	return m.GetBroadcastJobContext(context.Background(), broadcastJobID)
+}
+
+// GetBroadcastJobContext get broadcast job status by broadcast job ID with context
+func (m *MultichannelImpl) GetBroadcastJobContext(ctx context.Context, broadcastJobID int) (*GetBroadcastJobResponse, *qiscus.Error) {
+	resp := &GetBroadcastJobResponse{}
+	url := fmt.Sprintf("%s/api/v2/admin/broadcast_jobs/%d", m.APIBase(), broadcastJobID)
+
+	r := m.newRequest(http.MethodGet, url, nil, resp)
+
+	err := m.do(ctx, r)
+
+	return resp, err
+}
+
+// GetBroadcastLogs get delivery logs of a broadcast job
+func (m *MultichannelImpl) GetBroadcastLogs(req *GetBroadcastLogsReq) (*GetBroadcastLogsResponse, *qiscus.Error) {
+	return m.GetBroadcastLogsContext(context.Background(), req)
+}
+
+// GetBroadcastLogsContext get delivery logs of a broadcast job with context
+func (m *MultichannelImpl) GetBroadcastLogsContext(ctx context.Context, req *GetBroadcastLogsReq) (*GetBroadcastLogsResponse, *qiscus.Error) {
+	resp := &GetBroadcastLogsResponse{}
+	url := fmt.Sprintf("%s/api/v2/admin/broadcast_logs/%d", m.APIBase(), req.BroadcastJobID)
+
+	// Set default page
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	// Set default limit
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+
+	r := m.newRequest(http.MethodGet, url, nil, resp)
+
+	r.AddParameter("page", strconv.Itoa(req.Page))
+	r.AddParameter("limit", strconv.Itoa(req.Limit))
 
-	err := r.DoRequest()
+	err := m.do(ctx, r)
 
 	return resp, err
 }
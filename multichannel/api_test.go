@@ -1,12 +1,18 @@
 package multichannel
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/Qiscus-Integration/qiscus-go"
+	"github.com/Qiscus-Integration/qiscus-go/sdk"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -179,6 +185,38 @@ func TestSendMessageTextByBot(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestSendMessageByBot(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.Method, http.MethodPost)
+		assert.Equal(t, req.URL.Path, fmt.Sprintf("/%s/bot", qiscusAppID))
+		assert.Equal(t, req.Header.Get("Qiscus-App-Id"), qiscusAppID)
+		assert.Equal(t, req.Header.Get("Qiscus-Secret-Key"), qiscusSecretKey)
+
+		var body map[string]interface{}
+		json.NewDecoder(req.Body).Decode(&body)
+		assert.Equal(t, body["sender_email"], "bot@mail.com")
+		assert.Equal(t, body["type"], sdk.CommentTypeButtons)
+		assert.Equal(t, body["payload"].(map[string]interface{})["text"], "Choose")
+
+		rsp := `{"results":{"comment":{"id":10,"message":"Choose","type":"buttons","payload":{"text":"Choose","buttons":[{"label":"Yes","type":"postback","postback_text":"yes"}]},"user":{"user_id":"bot@mail.com"}}},"status":200}`
+		fmt.Fprint(w, rsp)
+	}))
+
+	defer srv.Close()
+
+	c := NewMultichannel(qiscusAppID, qiscusSecretKey)
+	c.SetAPIBase(srv.URL)
+
+	msg, e := sdk.NewButtonsMessage("bot@mail.com", roomID, "Choose", sdk.Button{Label: "Yes", Type: sdk.ButtonTypePostback, PostbackText: "yes"})
+	assert.Nil(t, e)
+
+	result, err := c.SendMessageByBot(NewSendMessageByBotReq(msg))
+	assert.Nil(t, err)
+	assert.Equal(t, result.Comment().ID, 10)
+	assert.Equal(t, result.Comment().User.UserID, "bot@mail.com")
+	assert.Equal(t, result.Comment().Payload.(*sdk.ButtonsPayload).Buttons[0].PostbackText, "yes")
+}
+
 func TestSetToggleBotInRoom(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		assert.Equal(t, req.Method, http.MethodPost)
@@ -334,7 +372,7 @@ func TestMarkAsResolved(t *testing.T) {
 		assert.Equal(t, req.Header.Get("Qiscus-App-Id"), qiscusAppID)
 		assert.Equal(t, req.Header.Get("Qiscus-Secret-Key"), qiscusSecretKey)
 
-		rsp := fmt.Sprintf(`{"data":{"service":{"room_id":"%s","notes":"%s","last_comment_id":"%d"}}}`, roomID, notes, lastCommentID)
+		rsp := fmt.Sprintf(`{"data":{"service":{"room_id":"%s","notes":"%s","last_comment_id":"%d","resolved_at":{"date":"2021-09-20 14:32:24.000000","timezone_type":1,"timezone":"+07:00"},"created_at":"2021-09-20T07:00:00Z"}}}`, roomID, notes, lastCommentID)
 		fmt.Fprint(w, rsp)
 	}))
 
@@ -351,6 +389,9 @@ func TestMarkAsResolved(t *testing.T) {
 	assert.Equal(t, result.Data.Service.RoomID, roomID)
 	assert.Equal(t, result.Data.Service.Notes, notes)
 	assert.Equal(t, result.Data.Service.LastCommentID, strconv.Itoa(lastCommentID))
+	assert.True(t, result.Data.Service.ResolvedAt.Equal(time.Date(2021, 9, 20, 7, 32, 24, 0, time.UTC)))
+	assert.Equal(t, result.Data.Service.CreatedAt.Time, time.Date(2021, 9, 20, 7, 0, 0, 0, time.UTC))
+	assert.True(t, result.Data.Service.RetrievedAt.IsZero())
 }
 
 func TestGetAllChannels(t *testing.T) {
@@ -400,3 +441,181 @@ func TestGetRoomByRoomID(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, result.Data.CustomerRoom.RoomID, roomID)
 }
+
+func TestGetRoomByRoomIDContextDeadline(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-req.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+
+	defer srv.Close()
+
+	c := NewMultichannel(qiscusAppID, qiscusSecretKey)
+	c.SetAPIBase(srv.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := c.GetRoomByRoomIDContext(ctx, roomID)
+	assert.NotNil(t, err)
+	assert.True(t, errors.Is(err.GetRawError(), context.DeadlineExceeded))
+}
+
+func TestGetHSMTemplates(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.Method, http.MethodGet)
+		assert.Equal(t, req.URL.Path, "/api/v2/admin/hsm")
+		assert.Equal(t, req.URL.Query().Get("channel_id"), "7")
+		assert.Equal(t, req.URL.Query().Get("approved"), "true")
+
+		rsp := `{"data":{"hsm_templates":[{"id":1,"name":"order_update","namespace":"ns","channel_id":7,"hsm_details":[{"id":2,"language":"id","content":"Order {{1}} is {{2}}","number_of_arguments":2,"approval_status":1}]}]},"meta":{"current_page":1,"total":1,"total_page":1}}`
+		fmt.Fprint(w, rsp)
+	}))
+
+	defer srv.Close()
+
+	c := NewMultichannel(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	result, err := c.GetHSMTemplates(&GetHSMTemplatesReq{ChannelID: 7, Approved: true})
+	assert.Nil(t, err)
+
+	detail, ok := result.Data.HsmTemplates[0].Detail("id")
+	assert.True(t, ok)
+	assert.True(t, detail.IsApproved())
+	assert.Equal(t, detail.NumberOfArguments, 2)
+}
+
+func TestSendHSM(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.Method, http.MethodPost)
+		assert.Equal(t, req.URL.Path, fmt.Sprintf("/whatsapp/v1/%s/7/messages", qiscusAppID))
+
+		var body SendHSMReq
+		json.NewDecoder(req.Body).Decode(&body)
+		assert.Equal(t, body.To, "6281234567890")
+		assert.Equal(t, body.Type, "template")
+		assert.Equal(t, body.Template.Name, "order_update")
+		assert.Equal(t, body.Template.Language, HSMLanguage{Policy: "deterministic", Code: "id"})
+		assert.Equal(t, body.Template.Components[0].Parameters, []HSMParameter{{Type: "text", Text: "INV-1"}, {Type: "text", Text: "shipped"}})
+
+		fmt.Fprint(w, `{"contacts":[{"input":"6281234567890","wa_id":"6281234567890"}],"messages":[{"id":"wamid.1"}]}`)
+	}))
+
+	defer srv.Close()
+
+	c := NewMultichannel(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	template := HSMTemplate{Name: "order_update", Namespace: "ns"}
+	result, err := c.SendHSM(NewSendHSMReq(7, "6281234567890", template, "id", "INV-1", "shipped"))
+	assert.Nil(t, err)
+	assert.Equal(t, result.Messages[0].ID, "wamid.1")
+}
+
+func TestCreateBroadcastJob(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.Method, http.MethodPost)
+		assert.Equal(t, req.URL.Path, "/api/v3/admin/broadcast/client")
+
+		var body CreateBroadcastJobReq
+		json.NewDecoder(req.Body).Decode(&body)
+		assert.Equal(t, body.TemplateDetailID, 2)
+		assert.Equal(t, body.Recipients[0].Variables, []string{"INV-1", "shipped"})
+
+		fmt.Fprint(w, `{"data":{"broadcast_job":{"id":5,"status":"queued","total_recipient":1}}}`)
+	}))
+
+	defer srv.Close()
+
+	c := NewMultichannel(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	result, err := c.CreateBroadcastJob(&CreateBroadcastJobReq{
+		Name:             "Order update",
+		ChannelID:        7,
+		TemplateDetailID: 2,
+		Recipients:       []BroadcastRecipient{{PhoneNumber: "6281234567890", Variables: []string{"INV-1", "shipped"}}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, result.Data.BroadcastJob.ID, 5)
+	assert.False(t, result.Data.BroadcastJob.Done())
+
+	// Empty recipients are rejected without calling the API
+	_, err = c.CreateBroadcastJob(&CreateBroadcastJobReq{ChannelID: 7, TemplateDetailID: 2})
+	assert.True(t, errors.Is(err, qiscus.ErrValidation))
+	assert.Contains(t, err.GetFieldErrors(), "recipients")
+}
+
+func TestGetBroadcastJob(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.Method, http.MethodGet)
+		assert.Equal(t, req.URL.Path, "/api/v2/admin/broadcast_jobs/5")
+
+		fmt.Fprint(w, `{"data":{"broadcast_job":{"id":5,"status":"completed","sent_count":9,"failed_count":1}}}`)
+	}))
+
+	defer srv.Close()
+
+	c := NewMultichannel(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	result, err := c.GetBroadcastJob(5)
+	assert.Nil(t, err)
+	assert.True(t, result.Data.BroadcastJob.Done())
+	assert.Equal(t, result.Data.BroadcastJob.FailedCount, 1)
+}
+
+func TestGetBroadcastLogs(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.Method, http.MethodGet)
+		assert.Equal(t, req.URL.Path, "/api/v2/admin/broadcast_logs/5")
+		assert.Equal(t, req.URL.Query().Get("page"), "1")
+
+		fmt.Fprint(w, `{"data":{"broadcast_logs":[{"id":1,"phone_number":"6281234567890","status":"failed","notes":"invalid phone number"}]},"meta":{"current_page":1,"total":1,"total_page":1}}`)
+	}))
+
+	defer srv.Close()
+
+	c := NewMultichannel(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	result, err := c.GetBroadcastLogs(&GetBroadcastLogsReq{BroadcastJobID: 5})
+	assert.Nil(t, err)
+	assert.Equal(t, result.Data.BroadcastLogs[0].Status, BroadcastLogStatusFailed)
+}
+
+func TestGetCustomerRooms(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.Method, http.MethodPost)
+		assert.Equal(t, req.URL.Path, "/api/v2/customer_rooms")
+		assert.Equal(t, req.Header.Get("Qiscus-App-Id"), qiscusAppID)
+		assert.Equal(t, req.Header.Get("Qiscus-Secret-Key"), qiscusSecretKey)
+
+		var body map[string]interface{}
+		json.NewDecoder(req.Body).Decode(&body)
+		assert.Equal(t, body["status"], CustomerRoomStatusWaiting)
+		assert.Equal(t, body["channels"], []interface{}{map[string]interface{}{"source": "wa", "channel_id": float64(7)}})
+		assert.Equal(t, body["tag_ids"], []interface{}{float64(1)})
+		assert.Equal(t, body["limit"], float64(50))
+		assert.Equal(t, body["start_date"], "2021-09-20 00:00:00")
+		assert.NotContains(t, body, "end_date")
+		assert.NotContains(t, body, "cursor_after")
+
+		rsp := fmt.Sprintf(`{"data":{"customer_rooms":[{"id":1,"room_id":"%s","is_waiting":true}]},"meta":{"cursor_after":"1","cursor_before":null},"status":200}`, roomID)
+		fmt.Fprint(w, rsp)
+	}))
+
+	defer srv.Close()
+
+	c := NewMultichannel(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	result, err := c.GetCustomerRooms(&GetCustomerRoomsReq{
+		Status:    CustomerRoomStatusWaiting,
+		Channels:  []CustomerRoomChannel{{Source: "wa", ChannelID: 7}},
+		TagIDs:    []int{1},
+		StartDate: time.Date(2021, 9, 20, 7, 0, 0, 0, time.FixedZone("WIB", 7*60*60)),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, result.Data.CustomerRooms[0].RoomID, roomID)
+	assert.True(t, result.Data.CustomerRooms[0].IsWaiting)
+	assert.Equal(t, result.Meta.CursorAfter, "1")
+	assert.Equal(t, result.Meta.CursorBefore, "")
+}
@@ -0,0 +1,40 @@
+package multichannel
+
+import (
+	"context"
+	"time"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+)
+
+// WaitBroadcastJob polls the broadcast job every interval until it is done, see BroadcastJob.Done,
+// or ctx is done. An interval of 0 polls every 5 seconds.
+func WaitBroadcastJob(ctx context.Context, c Multichannel, broadcastJobID int, interval time.Duration) (*BroadcastJob, *qiscus.Error) {
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		resp, err := c.GetBroadcastJobContext(ctx, broadcastJobID)
+		if err != nil {
+			return nil, err
+		}
+
+		job := resp.Data.BroadcastJob
+		if job.Done() {
+			return &job, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return &job, &qiscus.Error{
+				Message:  "wait broadcast job failed. " + ctx.Err().Error(),
+				RawError: ctx.Err(),
+			}
+		case <-ticker.C:
+		}
+	}
+}
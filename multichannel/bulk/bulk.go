@@ -0,0 +1,340 @@
+// Package bulk sends a WhatsApp HSM template message to every recipient of a CSV file
+// and writes a result CSV with the status of every row.
+//
+// The first row of the CSV is the header. The first column of each row is the phone number
+// of the recipient, the next columns are the template variables, in order:
+//
+//	phone_number,order,status
+//	6281234567890,INV-1,shipped
+//	+62 812-3456-7891,INV-2,delivered
+//
+// The result CSV has the columns of the input followed by status, message_id and error columns.
+package bulk
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Qiscus-Integration/qiscus-go/multichannel"
+)
+
+// Row status of the result CSV
+const (
+	StatusSent    = "sent"    // the template message is accepted by Qiscus
+	StatusInvalid = "invalid" // the row is rejected by validation, nothing is sent
+	StatusFailed  = "failed"  // Qiscus returned an error
+	StatusSkipped = "skipped" // the context is done before the row is sent
+)
+
+// ErrInvalidCSV is returned when the input is not a CSV with a header and a phone number column
+var ErrInvalidCSV = errors.New("bulk: invalid csv")
+
+// Summary counts the rows of a bulk send per status
+type Summary struct {
+	Total   int
+	Sent    int
+	Invalid int
+	Failed  int
+	Skipped int
+}
+
+// Option configures a Sender
+type Option func(*Sender)
+
+// WithConcurrency sets the number of messages sent concurrently, default 4
+func WithConcurrency(n int) Option {
+	return func(s *Sender) {
+		s.concurrency = n
+	}
+}
+
+// MaxRateLimit is the highest rate of WithRateLimit, one message per nanosecond
+const MaxRateLimit = int(time.Second)
+
+// WithRateLimit sets the maximum number of messages sent per second, default 0 is unlimited.
+// A rate above MaxRateLimit is clamped to MaxRateLimit.
+func WithRateLimit(perSecond int) Option {
+	return func(s *Sender) {
+		if perSecond > MaxRateLimit {
+			perSecond = MaxRateLimit
+		}
+		s.ratePerSecond = perSecond
+	}
+}
+
+// WithCountryCode sets the country code replacing the leading 0 of local phone numbers, e.g. "62".
+// By default, phone numbers with a leading 0 are invalid.
+func WithCountryCode(code string) Option {
+	return func(s *Sender) {
+		s.countryCode = strings.TrimPrefix(code, "+")
+	}
+}
+
+// Sender sends a template message to the recipients of a CSV
+type Sender struct {
+	client        multichannel.Multichannel
+	channelID     int
+	template      multichannel.HSMTemplate
+	language      string
+	detail        multichannel.HSMDetail
+	concurrency   int
+	ratePerSecond int
+	countryCode   string
+}
+
+// NewSender returns a sender of the template in language through the WhatsApp channel,
+// the template language must be approved
+func NewSender(c multichannel.Multichannel, channelID int, template multichannel.HSMTemplate, language string, opts ...Option) (*Sender, error) {
+	detail, ok := template.Detail(language)
+	if !ok {
+		return nil, fmt.Errorf("bulk: template %s has no language %s", template.Name, language)
+	}
+
+	if !detail.IsApproved() {
+		return nil, fmt.Errorf("bulk: template %s in language %s is not approved", template.Name, language)
+	}
+
+	s := &Sender{
+		client:      c,
+		channelID:   channelID,
+		template:    template,
+		language:    language,
+		detail:      detail,
+		concurrency: 4,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if s.concurrency < 1 {
+		s.concurrency = 1
+	}
+
+	return s, nil
+}
+
+// row is a recipient row of the input CSV and its result
+type row struct {
+	record    []string
+	phone     string
+	variables []string
+	status    string
+	messageID string
+	err       string
+}
+
+// Send sends the template to every valid row of in and writes the result CSV to out, in the input order.
+// Invalid rows are reported and not sent. When ctx is done, the rows not sent yet are skipped
+// and the context error is returned along with the summary.
+func (s *Sender) Send(ctx context.Context, in io.Reader, out io.Writer) (*Summary, error) {
+	header, rows, err := s.read(in)
+	if err != nil {
+		return nil, err
+	}
+
+	s.sendAll(ctx, rows)
+
+	summary := &Summary{Total: len(rows)}
+	w := csv.NewWriter(out)
+	_ = w.Write(append(append([]string(nil), header...), "status", "message_id", "error"))
+
+	for _, r := range rows {
+		switch r.status {
+		case StatusSent:
+			summary.Sent++
+		case StatusInvalid:
+			summary.Invalid++
+		case StatusFailed:
+			summary.Failed++
+		case StatusSkipped:
+			summary.Skipped++
+		}
+
+		// Pad short rows, so the result columns line up with the header
+		record := append([]string(nil), r.record...)
+		for len(record) < len(header) {
+			record = append(record, "")
+		}
+
+		_ = w.Write(append(record, r.status, r.messageID, r.err))
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return summary, fmt.Errorf("bulk: write result csv: %w", err)
+	}
+
+	return summary, ctx.Err()
+}
+
+// read parses and validates the rows of in
+func (s *Sender) read(in io.Reader) ([]string, []*row, error) {
+	r := csv.NewReader(in)
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, nil, fmt.Errorf("%w: missing header", ErrInvalidCSV)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", ErrInvalidCSV, err)
+	}
+
+	var rows []*row
+	seen := make(map[string]int)
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %s", ErrInvalidCSV, err)
+		}
+
+		rw := &row{record: record}
+		rows = append(rows, rw)
+
+		phone, msg := s.normalizePhone(record[0])
+		if msg == "" {
+			msg = s.validateVariables(record[1:])
+		}
+		if msg == "" {
+			if line, ok := seen[phone]; ok {
+				msg = fmt.Sprintf("duplicate phone number of row %d", line)
+			}
+		}
+		if msg != "" {
+			rw.status, rw.err = StatusInvalid, msg
+			continue
+		}
+
+		// Rows are numbered as in a spreadsheet, the header is row 1
+		seen[phone] = len(rows) + 1
+		rw.phone, rw.variables = phone, record[1:]
+	}
+
+	return header, rows, nil
+}
+
+// normalizePhone returns the phone number with digits only, or a validation message
+func (s *Sender) normalizePhone(phone string) (string, string) {
+	phone = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", ".", "").Replace(strings.TrimSpace(phone))
+	if phone == "" {
+		return "", "phone number is empty"
+	}
+
+	switch {
+	case strings.HasPrefix(phone, "+"):
+		phone = phone[1:]
+	case strings.HasPrefix(phone, "0"):
+		if s.countryCode == "" {
+			return "", "phone number has no country code"
+		}
+		phone = s.countryCode + phone[1:]
+	}
+
+	for _, c := range phone {
+		if c < '0' || c > '9' {
+			return "", "phone number must contain digits only"
+		}
+	}
+
+	// E.164 numbers have at most 15 digits
+	if len(phone) < 8 || len(phone) > 15 {
+		return "", "phone number must have 8 to 15 digits"
+	}
+
+	return phone, ""
+}
+
+// validateVariables returns a validation message of the template variables, empty when they are valid
+func (s *Sender) validateVariables(variables []string) string {
+	if len(variables) != s.detail.NumberOfArguments {
+		return fmt.Sprintf("template expects %d variables, got %d", s.detail.NumberOfArguments, len(variables))
+	}
+
+	for i, v := range variables {
+		switch {
+		case strings.TrimSpace(v) == "":
+			return fmt.Sprintf("variable %d is empty", i+1)
+		case strings.ContainsAny(v, "\n\t"):
+			return fmt.Sprintf("variable %d must not contain new line or tab", i+1)
+		case strings.Contains(v, "     "):
+			return fmt.Sprintf("variable %d must not contain more than 4 consecutive spaces", i+1)
+		}
+	}
+
+	return ""
+}
+
+// sendAll sends the valid rows with bounded concurrency, at most ratePerSecond messages per second
+func (s *Sender) sendAll(ctx context.Context, rows []*row) {
+	var tick <-chan time.Time
+	if s.ratePerSecond > 0 {
+		ticker := time.NewTicker(time.Second / time.Duration(s.ratePerSecond))
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	jobs := make(chan *row)
+	var wg sync.WaitGroup
+	for i := 0; i < s.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range jobs {
+				s.send(ctx, r)
+			}
+		}()
+	}
+
+	first := true
+	for _, r := range rows {
+		if r.status != "" {
+			continue
+		}
+
+		// The first message is sent right away, the next ones wait for the rate limiter
+		if tick != nil && !first {
+			select {
+			case <-tick:
+			case <-ctx.Done():
+			}
+		}
+		first = false
+
+		if ctx.Err() != nil {
+			r.status, r.err = StatusSkipped, ctx.Err().Error()
+			continue
+		}
+
+		jobs <- r
+	}
+
+	close(jobs)
+	wg.Wait()
+}
+
+// send sends the template message of the row and records the result
+func (s *Sender) send(ctx context.Context, r *row) {
+	req := multichannel.NewSendHSMReq(s.channelID, r.phone, s.template, s.language, r.variables...)
+
+	resp, err := s.client.SendHSMContext(ctx, req)
+	if err != nil {
+		r.status, r.err = StatusFailed, err.GetAPIMessage()
+		return
+	}
+
+	r.status = StatusSent
+	if len(resp.Messages) > 0 {
+		r.messageID = resp.Messages[0].ID
+	}
+}
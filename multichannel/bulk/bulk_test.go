@@ -0,0 +1,139 @@
+package bulk_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Qiscus-Integration/qiscus-go/multichannel"
+	"github.com/Qiscus-Integration/qiscus-go/multichannel/bulk"
+	"github.com/Qiscus-Integration/qiscus-go/multichannel/multichanneltest"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	qiscusAppID     = "test-qiscus-app-id"
+	qiscusSecretKey = "test-qiscus-secret-key"
+	channelID       = 7
+)
+
+func newSender(t *testing.T, opts ...bulk.Option) (*bulk.Sender, *multichanneltest.Server) {
+	fake := multichanneltest.NewServer(qiscusAppID, qiscusSecretKey)
+	t.Cleanup(fake.Close)
+
+	fake.AddHSMTemplate(channelID, "order_update", "id", "Order {{1}} is {{2}}", true)
+
+	c := multichannel.NewMultichannel(qiscusAppID, qiscusSecretKey, multichannel.WithAPIBase(fake.URL))
+	templates, err := c.GetHSMTemplates(&multichannel.GetHSMTemplatesReq{ChannelID: channelID})
+	assert.Nil(t, err)
+
+	s, e := bulk.NewSender(c, channelID, templates.Data.HsmTemplates[0], "id", opts...)
+	assert.Nil(t, e)
+
+	return s, fake
+}
+
+func TestSend(t *testing.T) {
+	s, fake := newSender(t, bulk.WithConcurrency(3), bulk.WithCountryCode("+62"))
+
+	in := strings.Join([]string{
+		"phone_number,order,status",
+		"6281234567890,INV-1,shipped",
+		"+62 812-3456-7891,INV-2,delivered",
+		"0812 3456 7892,INV-3,shipped",
+		"6281234567890,INV-4,shipped",
+		"62812345678x0,INV-5,shipped",
+		"6281234567893,INV-6",
+		"6281234567894,INV-7,\"line\nbreak\"",
+	}, "\n")
+
+	var out bytes.Buffer
+	summary, err := s.Send(context.Background(), strings.NewReader(in), &out)
+	assert.Nil(t, err)
+	assert.Equal(t, summary, &bulk.Summary{Total: 7, Sent: 3, Invalid: 4})
+
+	r := csv.NewReader(&out)
+	r.FieldsPerRecord = -1
+	result, _ := r.ReadAll()
+	assert.Equal(t, result[0], []string{"phone_number", "order", "status", "status", "message_id", "error"})
+	assert.Equal(t, result[1][3], bulk.StatusSent)
+	assert.NotEmpty(t, result[1][4])
+	assert.Equal(t, result[4][3:], []string{bulk.StatusInvalid, "", "duplicate phone number of row 2"})
+	assert.Equal(t, result[5][5], "phone number must contain digits only")
+	assert.Equal(t, result[6][5], "template expects 2 variables, got 1")
+	assert.Equal(t, result[7][5], "variable 2 must not contain new line or tab")
+
+	var to []string
+	for _, m := range fake.GetSentHSMs() {
+		to = append(to, m.To)
+	}
+	assert.ElementsMatch(t, to, []string{"6281234567890", "6281234567891", "6281234567892"})
+}
+
+func TestSendRateLimit(t *testing.T) {
+	s, _ := newSender(t, bulk.WithConcurrency(4), bulk.WithRateLimit(20))
+
+	in := "phone\n6281234567890,a,b\n6281234567891,a,b\n6281234567892,a,b\n6281234567893,a,b\n6281234567894,a,b\n"
+
+	start := time.Now()
+	summary, err := s.Send(context.Background(), strings.NewReader(in), &bytes.Buffer{})
+	assert.Nil(t, err)
+	assert.Equal(t, summary.Sent, 5)
+
+	// 5 messages at 20 per second take at least 4 intervals of 50ms
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(200*time.Millisecond))
+}
+
+func TestSendRateLimitTooHigh(t *testing.T) {
+	// The interval of a rate above one message per nanosecond would be 0
+	s, _ := newSender(t, bulk.WithRateLimit(int(^uint(0)>>1)))
+
+	in := "phone\n6281234567890,a,b\n6281234567891,a,b\n"
+
+	summary, err := s.Send(context.Background(), strings.NewReader(in), &bytes.Buffer{})
+	assert.Nil(t, err)
+	assert.Equal(t, summary.Sent, 2)
+}
+
+func TestSendFailedAndSkipped(t *testing.T) {
+	fake := multichanneltest.NewServer(qiscusAppID, qiscusSecretKey)
+	defer fake.Close()
+
+	c := multichannel.NewMultichannel(qiscusAppID, qiscusSecretKey, multichannel.WithAPIBase(fake.URL))
+
+	// The template is not known by the server, so Qiscus rejects every message
+	template := multichannel.HSMTemplate{Name: "unknown", HsmDetails: []multichannel.HSMDetail{{Language: "en", ApprovalStatus: multichannel.HSMStatusApproved}}}
+	s, e := bulk.NewSender(c, channelID, template, "en", bulk.WithConcurrency(1))
+	assert.Nil(t, e)
+
+	var out bytes.Buffer
+	summary, err := s.Send(context.Background(), strings.NewReader("phone\n6281234567890\n"), &out)
+	assert.Nil(t, err)
+	assert.Equal(t, summary.Failed, 1)
+	assert.Contains(t, out.String(), "approved template not found")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	summary, err = s.Send(ctx, strings.NewReader("phone\n6281234567890\n6281234567891\n"), &bytes.Buffer{})
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, summary.Skipped, 2)
+}
+
+func TestNewSender(t *testing.T) {
+	template := multichannel.HSMTemplate{Name: "promo", HsmDetails: []multichannel.HSMDetail{{Language: "id", ApprovalStatus: multichannel.HSMStatusPending}}}
+
+	_, err := bulk.NewSender(nil, channelID, template, "en")
+	assert.NotNil(t, err)
+
+	_, err = bulk.NewSender(nil, channelID, template, "id")
+	assert.NotNil(t, err)
+
+	s, _ := newSender(t)
+	_, err = s.Send(context.Background(), strings.NewReader(""), &bytes.Buffer{})
+	assert.True(t, errors.Is(err, bulk.ErrInvalidCSV))
+}
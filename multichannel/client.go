@@ -2,13 +2,19 @@ package multichannel
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
+	"reflect"
+	"sync"
+	"time"
 
 	"github.com/Qiscus-Integration/qiscus-go"
+	"github.com/rs/zerolog"
 )
 
 // APIBase is base Url the library uses to contact multichannel. Use SetAPIBase() to override
@@ -20,21 +26,52 @@ type Multichannel interface {
 	QiscusAppID() string
 	QiscusSecretKey() string
 	SetAPIBase(address string)
+	SetRetryPolicy(policy *qiscus.RetryPolicy)
+	AuthenticationToken() string
+	LongLivedToken() string
 
 	GetRoomTags(roomID string) (*RoomTagsResponse, *qiscus.Error)
+	GetRoomTagsContext(ctx context.Context, roomID string) (*RoomTagsResponse, *qiscus.Error)
 	CreateRoomTag(req *CreateRoomTagReq) (*CreateRoomTagResponse, *qiscus.Error)
+	CreateRoomTagContext(ctx context.Context, req *CreateRoomTagReq) (*CreateRoomTagResponse, *qiscus.Error)
 	CreateAdditionalInfoRoomWithReplace(roomID string, req *CreateAdditionalInfoRoomReq) (*CreateAdditionalInfoRoomResponse, *qiscus.Error)
+	CreateAdditionalInfoRoomWithReplaceContext(ctx context.Context, roomID string, req *CreateAdditionalInfoRoomReq) (*CreateAdditionalInfoRoomResponse, *qiscus.Error)
 	GetAdditionalInfoRoom(roomID string) (*GetAdditionalInfoRoomResponse, *qiscus.Error)
+	GetAdditionalInfoRoomContext(ctx context.Context, roomID string) (*GetAdditionalInfoRoomResponse, *qiscus.Error)
 	CreateAdditionalInfoRoom(roomID string, req *CreateAdditionalInfoRoomReq) (*CreateAdditionalInfoRoomResponse, *qiscus.Error)
+	CreateAdditionalInfoRoomContext(ctx context.Context, roomID string, req *CreateAdditionalInfoRoomReq) (*CreateAdditionalInfoRoomResponse, *qiscus.Error)
 	MarkAsResolved(req *MarkAsResolvedReq) (*MarkAsResolvedResponse, *qiscus.Error)
+	MarkAsResolvedContext(ctx context.Context, req *MarkAsResolvedReq) (*MarkAsResolvedResponse, *qiscus.Error)
 	GetRoomByRoomID(roomID string) (*GetRoomByRoomIDResponse, *qiscus.Error)
+	GetRoomByRoomIDContext(ctx context.Context, roomID string) (*GetRoomByRoomIDResponse, *qiscus.Error)
 	SendMessageTextByBot(req *SendMessageTextByBotReq) *qiscus.Error
+	SendMessageTextByBotContext(ctx context.Context, req *SendMessageTextByBotReq) *qiscus.Error
+	SendMessageByBot(req *SendMessageByBotReq) (*SendMessageByBotResponse, *qiscus.Error)
+	SendMessageByBotContext(ctx context.Context, req *SendMessageByBotReq) (*SendMessageByBotResponse, *qiscus.Error)
 	SetToggleBotInRoom(roomID string, isActive bool) (*SetToggleBotInRoomResponse, *qiscus.Error)
+	SetToggleBotInRoomContext(ctx context.Context, roomID string, isActive bool) (*SetToggleBotInRoomResponse, *qiscus.Error)
 	GetAllAgents(req *GetAllAgentsReq) (*GetAllAgentsResponse, *qiscus.Error)
+	GetAllAgentsContext(ctx context.Context, req *GetAllAgentsReq) (*GetAllAgentsResponse, *qiscus.Error)
 	AssignAgent(req *AssignAgentReq) (*AssignAgentResponse, *qiscus.Error)
+	AssignAgentContext(ctx context.Context, req *AssignAgentReq) (*AssignAgentResponse, *qiscus.Error)
 	GetAgentsByDivision(req *GetAgentsByDivisionReq) (*GetAgentsByDivisionResponse, *qiscus.Error)
+	GetAgentsByDivisionContext(ctx context.Context, req *GetAgentsByDivisionReq) (*GetAgentsByDivisionResponse, *qiscus.Error)
 	GetAllDivision(req *GetAllDivisionReq) (*GetAllDivisionResponse, *qiscus.Error)
+	GetAllDivisionContext(ctx context.Context, req *GetAllDivisionReq) (*GetAllDivisionResponse, *qiscus.Error)
 	GetAllChannels() (*GetAllChannelsResponse, *qiscus.Error)
+	GetAllChannelsContext(ctx context.Context) (*GetAllChannelsResponse, *qiscus.Error)
+	GetCustomerRooms(req *GetCustomerRoomsReq) (*GetCustomerRoomsResponse, *qiscus.Error)
+	GetCustomerRoomsContext(ctx context.Context, req *GetCustomerRoomsReq) (*GetCustomerRoomsResponse, *qiscus.Error)
+	GetHSMTemplates(req *GetHSMTemplatesReq) (*GetHSMTemplatesResponse, *qiscus.Error)
+	GetHSMTemplatesContext(ctx context.Context, req *GetHSMTemplatesReq) (*GetHSMTemplatesResponse, *qiscus.Error)
+	SendHSM(req *SendHSMReq) (*SendHSMResponse, *qiscus.Error)
+	SendHSMContext(ctx context.Context, req *SendHSMReq) (*SendHSMResponse, *qiscus.Error)
+	CreateBroadcastJob(req *CreateBroadcastJobReq) (*CreateBroadcastJobResponse, *qiscus.Error)
+	CreateBroadcastJobContext(ctx context.Context, req *CreateBroadcastJobReq) (*CreateBroadcastJobResponse, *qiscus.Error)
+	GetBroadcastJob(broadcastJobID int) (*GetBroadcastJobResponse, *qiscus.Error)
+	GetBroadcastJobContext(ctx context.Context, broadcastJobID int) (*GetBroadcastJobResponse, *qiscus.Error)
+	GetBroadcastLogs(req *GetBroadcastLogsReq) (*GetBroadcastLogsResponse, *qiscus.Error)
+	GetBroadcastLogsContext(ctx context.Context, req *GetBroadcastLogsReq) (*GetBroadcastLogsResponse, *qiscus.Error)
 }
 
 // MultichannelImpl bundles data needed by a large number of methods in order to interact with the Multichannel API.
@@ -42,20 +79,52 @@ type MultichannelImpl struct {
 	apiBase         string
 	qiscusAppID     string
 	qiscusSecretKey string
+	retryPolicy     *qiscus.RetryPolicy
+	retryPolicySet  bool // the retry policy overrides qiscus.DefaultRetryPolicy, even when nil
+	httpClient      *http.Client
+	timeout         time.Duration
+	logger          *zerolog.Logger
+	userAgent       string
+
+	// Admin credential of a client created by NewMultichannelFromCredential
+	authMu              sync.Mutex
+	reloginMu           sync.Mutex
+	email               string
+	password            string
+	authenticationToken string
+	longLivedToken      string
 }
 
 // NewMultichannel creates a new client instance.
-func NewMultichannel(qiscusAppID, qiscusSecretKey string) Multichannel {
-	return &MultichannelImpl{
+func NewMultichannel(qiscusAppID, qiscusSecretKey string, opts ...Option) Multichannel {
+	m := &MultichannelImpl{
 		apiBase:         APIBase,
 		qiscusAppID:     qiscusAppID,
 		qiscusSecretKey: qiscusSecretKey,
 	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	// Apply the timeout on a copy, so the given or default HTTP client is left untouched
+	if m.timeout > 0 {
+		httpClient := qiscus.DefaultHttpClient
+		if m.httpClient != nil {
+			httpClient = m.httpClient
+		}
+
+		c := *httpClient
+		c.Timeout = m.timeout
+		m.httpClient = &c
+	}
+
+	return m
 }
 
 // NewMultichannelFromEnv returns a new Multichannel client using the environment variables
 // QISCUS_APP_ID, QISCUS_SECRET_KEY and MULTICHANNEL_API_BASE
-func NewMultichannelFromEnv() (Multichannel, error) {
+func NewMultichannelFromEnv(opts ...Option) (Multichannel, error) {
 	qiscusAppID := os.Getenv("QISCUS_APP_ID")
 	if qiscusAppID == "" {
 		return nil, errors.New("required environment variable QISCUS_APP_ID not defined")
@@ -66,32 +135,30 @@ func NewMultichannelFromEnv() (Multichannel, error) {
 		return nil, errors.New("required environment variable QISCUS_SECRET_KEY not defined")
 	}
 
-	m := NewMultichannel(qiscusAppID, qiscusSecretKey)
-
-	url := os.Getenv("MULTICHANNEL_API_BASE")
-	if url != "" {
-		m.SetAPIBase(url)
+	// The environment sets the defaults, the given options take precedence
+	if url := os.Getenv("MULTICHANNEL_API_BASE"); url != "" {
+		opts = append([]Option{WithAPIBase(url)}, opts...)
 	}
 
+	m := NewMultichannel(qiscusAppID, qiscusSecretKey, opts...)
+
 	return m, nil
 
 }
 
-func NewMultichannelFromCredential(email, password string) (Multichannel, error) {
-	resp := &LoginAdminResponse{}
-	url := fmt.Sprintf("%s/api/v1/auth", APIBase)
-
-	req := &LoginAdminReq{Email: email, Password: password}
-	jsonReq, _ := json.Marshal(req)
+// NewMultichannelFromCredential logs in with the admin email and password and returns a new client
+// using the app ID and secret key of the admin. Requests are also authorized with the admin token,
+// so the client can call admin endpoints, and the client logs in again when the token is rejected.
+func NewMultichannelFromCredential(email, password string, opts ...Option) (Multichannel, error) {
+	m := NewMultichannel("", "", opts...).(*MultichannelImpl)
+	m.email = email
+	m.password = password
 
-	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
-	if err := r.DoRequest(); err != nil {
+	if err := m.login(context.Background()); err != nil {
 		return nil, fmt.Errorf("initiate client for multichannel failed. %s", err.Message)
 	}
 
-	m := NewMultichannel(resp.Data.User.App.AppCode, resp.Data.User.App.SecretKey)
 	return m, nil
-
 }
 
 // APIBase returns the API Base URL configured for this client
@@ -101,11 +168,17 @@ func (m *MultichannelImpl) APIBase() string {
 
 // QiscusAppID returns the App ID configured for this client
 func (m *MultichannelImpl) QiscusAppID() string {
+	m.authMu.Lock()
+	defer m.authMu.Unlock()
+
 	return m.qiscusAppID
 }
 
 // QiscusSecretKey returns the Secret Key configured for this client
 func (m *MultichannelImpl) QiscusSecretKey() string {
+	m.authMu.Lock()
+	defer m.authMu.Unlock()
+
 	return m.qiscusSecretKey
 }
 
@@ -114,3 +187,138 @@ func (m *MultichannelImpl) QiscusSecretKey() string {
 func (m *MultichannelImpl) SetAPIBase(address string) {
 	m.apiBase = address
 }
+
+// SetRetryPolicy sets the retry policy used by every request of this client, overriding qiscus.DefaultRetryPolicy.
+// Set a custom retry policy: m.SetRetryPolicy(qiscus.NewRetryPolicy())
+func (m *MultichannelImpl) SetRetryPolicy(policy *qiscus.RetryPolicy) {
+	m.retryPolicy = policy
+	m.retryPolicySet = true
+}
+
+// AuthenticationToken returns the admin token of a client created by NewMultichannelFromCredential, empty otherwise
+func (m *MultichannelImpl) AuthenticationToken() string {
+	m.authMu.Lock()
+	defer m.authMu.Unlock()
+
+	return m.authenticationToken
+}
+
+// LongLivedToken returns the long lived token of a client created by NewMultichannelFromCredential, empty otherwise
+func (m *MultichannelImpl) LongLivedToken() string {
+	m.authMu.Lock()
+	defer m.authMu.Unlock()
+
+	return m.longLivedToken
+}
+
+// login logs in with the admin credential and keeps the app credential and tokens of the admin
+func (m *MultichannelImpl) login(ctx context.Context) *qiscus.Error {
+	resp := &LoginAdminResponse{}
+	url := fmt.Sprintf("%s/api/v1/auth", m.APIBase())
+
+	req := &LoginAdminReq{Email: m.email, Password: m.password}
+	jsonReq, _ := json.Marshal(req)
+
+	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
+	m.configure(r)
+
+	if err := qiscus.DoRequestContext(ctx, r); err != nil {
+		return err
+	}
+
+	m.authMu.Lock()
+	defer m.authMu.Unlock()
+
+	m.qiscusAppID = resp.Data.User.App.AppCode
+	m.qiscusSecretKey = resp.Data.User.App.SecretKey
+	m.authenticationToken = resp.Data.User.AuthenticationToken
+	m.longLivedToken = resp.Data.LongLivedToken
+
+	return nil
+}
+
+// do sends the request, authorized with the admin token when the client has one.
+// A request rejected with 401 is sent again once, after logging in again.
+func (m *MultichannelImpl) do(ctx context.Context, r qiscus.HttpRequest) *qiscus.Error {
+	token := m.AuthenticationToken()
+	if token != "" {
+		r.AddHeader("Authorization", token)
+	}
+
+	err := qiscus.DoRequestContext(ctx, r)
+	if err == nil || err.GetStatusCode() != http.StatusUnauthorized || m.email == "" {
+		return err
+	}
+
+	if e := m.relogin(ctx, token); e != nil {
+		return e
+	}
+
+	r.AddHeader("Qiscus-App-Id", m.QiscusAppID())
+	r.AddHeader("Qiscus-Secret-Key", m.QiscusSecretKey())
+	r.AddHeader("Authorization", m.AuthenticationToken())
+
+	// The rejected attempt decoded its error body into the response, start the retry from a zero response
+	resetResponse(r)
+
+	return qiscus.DoRequestContext(ctx, r)
+}
+
+// resetResponse sets the response of r back to its zero value
+func resetResponse(r qiscus.HttpRequest) {
+	impl, ok := r.(*qiscus.HttpRequestImpl)
+	if !ok || impl.Response == nil {
+		return
+	}
+
+	v := reflect.ValueOf(impl.Response)
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		v.Elem().Set(reflect.Zero(v.Elem().Type()))
+	}
+}
+
+// relogin logs in again, unless a concurrent request already replaced the rejected token
+func (m *MultichannelImpl) relogin(ctx context.Context, rejected string) *qiscus.Error {
+	m.reloginMu.Lock()
+	defer m.reloginMu.Unlock()
+
+	if m.AuthenticationToken() != rejected {
+		return nil
+	}
+
+	return m.login(ctx)
+}
+
+// newRequest creates a request authenticated with the credentials of this client
+func (m *MultichannelImpl) newRequest(method, url string, body io.Reader, resp interface{}) qiscus.HttpRequest {
+	r := qiscus.NewHttpRequest(method, url, body, resp)
+	r.AddHeader("Qiscus-App-Id", m.QiscusAppID())
+	r.AddHeader("Qiscus-Secret-Key", m.QiscusSecretKey())
+	m.configure(r)
+
+	return r
+}
+
+// configure applies the HTTP settings of this client to r
+func (m *MultichannelImpl) configure(r qiscus.HttpRequest) {
+	settings, ok := r.(qiscus.HttpRequestSettings)
+	if !ok {
+		return
+	}
+
+	if m.retryPolicySet {
+		settings.SetRetryPolicy(m.retryPolicy)
+	}
+
+	if m.httpClient != nil {
+		settings.SetHttpClient(m.httpClient)
+	}
+
+	if m.userAgent != "" {
+		settings.SetUserAgent(m.userAgent)
+	}
+
+	if m.logger != nil {
+		settings.SetLogger(m.logger)
+	}
+}
@@ -1,8 +1,16 @@
 package multichannel
 
 import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/Qiscus-Integration/qiscus-go"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,3 +25,146 @@ func TestNewMultichannel(t *testing.T) {
 	assert.Equal(t, c.QiscusAppID(), qiscusAppID)
 	assert.Equal(t, c.QiscusSecretKey(), qiscusSecretKey)
 }
+
+func TestNewMultichannelWithOptions(t *testing.T) {
+	c1 := NewMultichannel(qiscusAppID, qiscusSecretKey, WithTimeout(time.Second))
+	c2 := NewMultichannel(qiscusAppID, qiscusSecretKey, WithTimeout(2*time.Second), WithAPIBase("https://multichannel2.qiscus.com"))
+
+	// Each client keeps its own HTTP client and API base
+	assert.Equal(t, c1.(*MultichannelImpl).httpClient.Timeout, time.Second)
+	assert.Equal(t, c2.(*MultichannelImpl).httpClient.Timeout, 2*time.Second)
+	assert.Equal(t, c1.APIBase(), APIBase)
+	assert.Equal(t, c2.APIBase(), "https://multichannel2.qiscus.com")
+	assert.Equal(t, qiscus.DefaultHttpClient.Timeout, qiscus.DefaultHttpTimeout)
+}
+
+func TestWithRetryPolicyNil(t *testing.T) {
+	var attempts int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+
+	defer srv.Close()
+
+	defaultRetryPolicy := qiscus.DefaultRetryPolicy
+	defer func() { qiscus.DefaultRetryPolicy = defaultRetryPolicy }()
+
+	qiscus.DefaultRetryPolicy = &qiscus.RetryPolicy{
+		MaxAttempts:          3,
+		InitialBackoff:       time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusServiceUnavailable},
+	}
+
+	// A nil retry policy opts the client out of the global one
+	_, err := NewMultichannel(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL), WithRetryPolicy(nil)).GetRoomTags("123")
+	assert.NotNil(t, err)
+	assert.Equal(t, atomic.LoadInt32(&attempts), int32(1))
+}
+
+func TestNewMultichannelFromEnv(t *testing.T) {
+	for name, value := range map[string]string{
+		"QISCUS_APP_ID":         qiscusAppID,
+		"QISCUS_SECRET_KEY":     qiscusSecretKey,
+		"MULTICHANNEL_API_BASE": "https://multichannel-env.qiscus.com",
+	} {
+		old, ok := os.LookupEnv(name)
+		os.Setenv(name, value)
+		defer func(name string) {
+			if ok {
+				os.Setenv(name, old)
+			} else {
+				os.Unsetenv(name)
+			}
+		}(name)
+	}
+
+	c, err := NewMultichannelFromEnv()
+	assert.Nil(t, err)
+	assert.Equal(t, c.APIBase(), "https://multichannel-env.qiscus.com")
+
+	// An explicit option takes precedence over the environment
+	c, err = NewMultichannelFromEnv(WithAPIBase("https://multichannel-option.qiscus.com"))
+	assert.Nil(t, err)
+	assert.Equal(t, c.APIBase(), "https://multichannel-option.qiscus.com")
+}
+
+func TestNewMultichannelFromCredential(t *testing.T) {
+	var logins, calls int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/api/v1/auth" {
+			n := atomic.AddInt32(&logins, 1)
+			fmt.Fprintf(w, `{"data":{"user":{"authentication_token":"token-%d","app":{"app_code":"%s","secret_key":"%s"}},"long_lived_token":"long-lived"}}`, n, qiscusAppID, qiscusSecretKey)
+			return
+		}
+
+		assert.Equal(t, req.Header.Get("Qiscus-App-Id"), qiscusAppID)
+		assert.Equal(t, req.Header.Get("Qiscus-Secret-Key"), qiscusSecretKey)
+
+		// The first token expires after the first call
+		if atomic.AddInt32(&calls, 1) > 1 && req.Header.Get("Authorization") == "token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"errors":"Unauthorized","status":401}`)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		assert.JSONEq(t, string(body), `{"room_id":"123123","tag":"vip"}`)
+		fmt.Fprint(w, `{"data":{"id":1,"name":"vip"}}`)
+	}))
+
+	defer srv.Close()
+
+	c, err := NewMultichannelFromCredential("admin@mail.com", "secret", WithAPIBase(srv.URL))
+	assert.Nil(t, err)
+	assert.Equal(t, c.QiscusAppID(), qiscusAppID)
+	assert.Equal(t, c.AuthenticationToken(), "token-1")
+	assert.Equal(t, c.LongLivedToken(), "long-lived")
+
+	_, qErr := c.CreateRoomTag(&CreateRoomTagReq{RoomID: "123123", Tag: "vip"})
+	assert.Nil(t, qErr)
+
+	// The rejected request is sent again with the new token, body included
+	resp, qErr := c.CreateRoomTag(&CreateRoomTagReq{RoomID: "123123", Tag: "vip"})
+	assert.Nil(t, qErr)
+	assert.Equal(t, resp.Data.Name, "vip")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&logins))
+	assert.Equal(t, c.AuthenticationToken(), "token-2")
+
+	_, err = NewMultichannelFromCredential("admin@mail.com", "secret", WithAPIBase("http://127.0.0.1:0"))
+	assert.NotNil(t, err)
+}
+
+func TestReloginResetsResponse(t *testing.T) {
+	var logins int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/api/v1/auth" {
+			n := atomic.AddInt32(&logins, 1)
+			fmt.Fprintf(w, `{"data":{"user":{"authentication_token":"token-%d","app":{"app_code":"%s","secret_key":"%s"}}}}`, n, qiscusAppID, qiscusSecretKey)
+			return
+		}
+
+		if req.Header.Get("Authorization") == "token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"data":{"id":-1,"name":"unauthorized"},"status":401}`)
+			return
+		}
+
+		fmt.Fprint(w, `{"data":{"name":"vip"}}`)
+	}))
+
+	defer srv.Close()
+
+	c, err := NewMultichannelFromCredential("admin@mail.com", "secret", WithAPIBase(srv.URL))
+	assert.Nil(t, err)
+
+	// Fields left out of the success body are not kept from the error body
+	resp, qErr := c.CreateRoomTag(&CreateRoomTagReq{RoomID: "123123", Tag: "vip"})
+	assert.Nil(t, qErr)
+	assert.Equal(t, resp.Data.ID, 0)
+	assert.Equal(t, resp.Data.Name, "vip")
+	assert.Equal(t, c.AuthenticationToken(), "token-2")
+}
@@ -0,0 +1,616 @@
+// Code generated by fakegen. DO NOT EDIT.
+
+package multichanneltest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+	"github.com/Qiscus-Integration/qiscus-go/multichannel"
+)
+
+// Call is a call recorded by FakeMultichannel
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// FakeMultichannel is a fake multichannel.Multichannel for unit tests.
+// Every method records its call and calls the matching Func field when set.
+// A method without Func falls back to its Context variant Func, otherwise it returns empty results.
+type FakeMultichannel struct {
+	mu    sync.Mutex
+	calls []Call
+
+	APIBaseFunc                                    func() string
+	QiscusAppIDFunc                                func() string
+	QiscusSecretKeyFunc                            func() string
+	SetAPIBaseFunc                                 func(string)
+	SetRetryPolicyFunc                             func(*qiscus.RetryPolicy)
+	AuthenticationTokenFunc                        func() string
+	LongLivedTokenFunc                             func() string
+	GetRoomTagsFunc                                func(string) (*multichannel.RoomTagsResponse, *qiscus.Error)
+	GetRoomTagsContextFunc                         func(context.Context, string) (*multichannel.RoomTagsResponse, *qiscus.Error)
+	CreateRoomTagFunc                              func(*multichannel.CreateRoomTagReq) (*multichannel.CreateRoomTagResponse, *qiscus.Error)
+	CreateRoomTagContextFunc                       func(context.Context, *multichannel.CreateRoomTagReq) (*multichannel.CreateRoomTagResponse, *qiscus.Error)
+	CreateAdditionalInfoRoomWithReplaceFunc        func(string, *multichannel.CreateAdditionalInfoRoomReq) (*multichannel.CreateAdditionalInfoRoomResponse, *qiscus.Error)
+	CreateAdditionalInfoRoomWithReplaceContextFunc func(context.Context, string, *multichannel.CreateAdditionalInfoRoomReq) (*multichannel.CreateAdditionalInfoRoomResponse, *qiscus.Error)
+	GetAdditionalInfoRoomFunc                      func(string) (*multichannel.GetAdditionalInfoRoomResponse, *qiscus.Error)
+	GetAdditionalInfoRoomContextFunc               func(context.Context, string) (*multichannel.GetAdditionalInfoRoomResponse, *qiscus.Error)
+	CreateAdditionalInfoRoomFunc                   func(string, *multichannel.CreateAdditionalInfoRoomReq) (*multichannel.CreateAdditionalInfoRoomResponse, *qiscus.Error)
+	CreateAdditionalInfoRoomContextFunc            func(context.Context, string, *multichannel.CreateAdditionalInfoRoomReq) (*multichannel.CreateAdditionalInfoRoomResponse, *qiscus.Error)
+	MarkAsResolvedFunc                             func(*multichannel.MarkAsResolvedReq) (*multichannel.MarkAsResolvedResponse, *qiscus.Error)
+	MarkAsResolvedContextFunc                      func(context.Context, *multichannel.MarkAsResolvedReq) (*multichannel.MarkAsResolvedResponse, *qiscus.Error)
+	GetRoomByRoomIDFunc                            func(string) (*multichannel.GetRoomByRoomIDResponse, *qiscus.Error)
+	GetRoomByRoomIDContextFunc                     func(context.Context, string) (*multichannel.GetRoomByRoomIDResponse, *qiscus.Error)
+	SendMessageTextByBotFunc                       func(*multichannel.SendMessageTextByBotReq) *qiscus.Error
+	SendMessageTextByBotContextFunc                func(context.Context, *multichannel.SendMessageTextByBotReq) *qiscus.Error
+	SendMessageByBotFunc                           func(*multichannel.SendMessageByBotReq) (*multichannel.SendMessageByBotResponse, *qiscus.Error)
+	SendMessageByBotContextFunc                    func(context.Context, *multichannel.SendMessageByBotReq) (*multichannel.SendMessageByBotResponse, *qiscus.Error)
+	SetToggleBotInRoomFunc                         func(string, bool) (*multichannel.SetToggleBotInRoomResponse, *qiscus.Error)
+	SetToggleBotInRoomContextFunc                  func(context.Context, string, bool) (*multichannel.SetToggleBotInRoomResponse, *qiscus.Error)
+	GetAllAgentsFunc                               func(*multichannel.GetAllAgentsReq) (*multichannel.GetAllAgentsResponse, *qiscus.Error)
+	GetAllAgentsContextFunc                        func(context.Context, *multichannel.GetAllAgentsReq) (*multichannel.GetAllAgentsResponse, *qiscus.Error)
+	AssignAgentFunc                                func(*multichannel.AssignAgentReq) (*multichannel.AssignAgentResponse, *qiscus.Error)
+	AssignAgentContextFunc                         func(context.Context, *multichannel.AssignAgentReq) (*multichannel.AssignAgentResponse, *qiscus.Error)
+	GetAgentsByDivisionFunc                        func(*multichannel.GetAgentsByDivisionReq) (*multichannel.GetAgentsByDivisionResponse, *qiscus.Error)
+	GetAgentsByDivisionContextFunc                 func(context.Context, *multichannel.GetAgentsByDivisionReq) (*multichannel.GetAgentsByDivisionResponse, *qiscus.Error)
+	GetAllDivisionFunc                             func(*multichannel.GetAllDivisionReq) (*multichannel.GetAllDivisionResponse, *qiscus.Error)
+	GetAllDivisionContextFunc                      func(context.Context, *multichannel.GetAllDivisionReq) (*multichannel.GetAllDivisionResponse, *qiscus.Error)
+	GetAllChannelsFunc                             func() (*multichannel.GetAllChannelsResponse, *qiscus.Error)
+	GetAllChannelsContextFunc                      func(context.Context) (*multichannel.GetAllChannelsResponse, *qiscus.Error)
+	GetCustomerRoomsFunc                           func(*multichannel.GetCustomerRoomsReq) (*multichannel.GetCustomerRoomsResponse, *qiscus.Error)
+	GetCustomerRoomsContextFunc                    func(context.Context, *multichannel.GetCustomerRoomsReq) (*multichannel.GetCustomerRoomsResponse, *qiscus.Error)
+	GetHSMTemplatesFunc                            func(*multichannel.GetHSMTemplatesReq) (*multichannel.GetHSMTemplatesResponse, *qiscus.Error)
+	GetHSMTemplatesContextFunc                     func(context.Context, *multichannel.GetHSMTemplatesReq) (*multichannel.GetHSMTemplatesResponse, *qiscus.Error)
+	SendHSMFunc                                    func(*multichannel.SendHSMReq) (*multichannel.SendHSMResponse, *qiscus.Error)
+	SendHSMContextFunc                             func(context.Context, *multichannel.SendHSMReq) (*multichannel.SendHSMResponse, *qiscus.Error)
+	CreateBroadcastJobFunc                         func(*multichannel.CreateBroadcastJobReq) (*multichannel.CreateBroadcastJobResponse, *qiscus.Error)
+	CreateBroadcastJobContextFunc                  func(context.Context, *multichannel.CreateBroadcastJobReq) (*multichannel.CreateBroadcastJobResponse, *qiscus.Error)
+	GetBroadcastJobFunc                            func(int) (*multichannel.GetBroadcastJobResponse, *qiscus.Error)
+	GetBroadcastJobContextFunc                     func(context.Context, int) (*multichannel.GetBroadcastJobResponse, *qiscus.Error)
+	GetBroadcastLogsFunc                           func(*multichannel.GetBroadcastLogsReq) (*multichannel.GetBroadcastLogsResponse, *qiscus.Error)
+	GetBroadcastLogsContextFunc                    func(context.Context, *multichannel.GetBroadcastLogsReq) (*multichannel.GetBroadcastLogsResponse, *qiscus.Error)
+}
+
+var _ multichannel.Multichannel = (*FakeMultichannel)(nil)
+
+// Calls returns the recorded calls in order
+func (f *FakeMultichannel) Calls() []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]Call(nil), f.calls...)
+}
+
+// CallsTo returns the recorded calls of a method in order
+func (f *FakeMultichannel) CallsTo(method string) []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var calls []Call
+	for _, c := range f.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// Reset clears the recorded calls
+func (f *FakeMultichannel) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = nil
+}
+
+func (f *FakeMultichannel) record(method string, args ...interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = append(f.calls, Call{Method: method, Args: args})
+}
+
+// APIBase calls APIBaseFunc
+func (f *FakeMultichannel) APIBase() string {
+	f.record("APIBase")
+	if f.APIBaseFunc != nil {
+		return f.APIBaseFunc()
+	}
+	return ""
+}
+
+// QiscusAppID calls QiscusAppIDFunc
+func (f *FakeMultichannel) QiscusAppID() string {
+	f.record("QiscusAppID")
+	if f.QiscusAppIDFunc != nil {
+		return f.QiscusAppIDFunc()
+	}
+	return ""
+}
+
+// QiscusSecretKey calls QiscusSecretKeyFunc
+func (f *FakeMultichannel) QiscusSecretKey() string {
+	f.record("QiscusSecretKey")
+	if f.QiscusSecretKeyFunc != nil {
+		return f.QiscusSecretKeyFunc()
+	}
+	return ""
+}
+
+// SetAPIBase calls SetAPIBaseFunc
+func (f *FakeMultichannel) SetAPIBase(address string) {
+	f.record("SetAPIBase", address)
+	if f.SetAPIBaseFunc != nil {
+		f.SetAPIBaseFunc(address)
+	}
+}
+
+// SetRetryPolicy calls SetRetryPolicyFunc
+func (f *FakeMultichannel) SetRetryPolicy(policy *qiscus.RetryPolicy) {
+	f.record("SetRetryPolicy", policy)
+	if f.SetRetryPolicyFunc != nil {
+		f.SetRetryPolicyFunc(policy)
+	}
+}
+
+// AuthenticationToken calls AuthenticationTokenFunc
+func (f *FakeMultichannel) AuthenticationToken() string {
+	f.record("AuthenticationToken")
+	if f.AuthenticationTokenFunc != nil {
+		return f.AuthenticationTokenFunc()
+	}
+	return ""
+}
+
+// LongLivedToken calls LongLivedTokenFunc
+func (f *FakeMultichannel) LongLivedToken() string {
+	f.record("LongLivedToken")
+	if f.LongLivedTokenFunc != nil {
+		return f.LongLivedTokenFunc()
+	}
+	return ""
+}
+
+// GetRoomTags calls GetRoomTagsFunc
+func (f *FakeMultichannel) GetRoomTags(roomID string) (*multichannel.RoomTagsResponse, *qiscus.Error) {
+	f.record("GetRoomTags", roomID)
+	if f.GetRoomTagsFunc != nil {
+		return f.GetRoomTagsFunc(roomID)
+	}
+	if f.GetRoomTagsContextFunc != nil {
+		return f.GetRoomTagsContextFunc(context.Background(), roomID)
+	}
+	return &multichannel.RoomTagsResponse{}, nil
+}
+
+// GetRoomTagsContext calls GetRoomTagsContextFunc
+func (f *FakeMultichannel) GetRoomTagsContext(ctx context.Context, roomID string) (*multichannel.RoomTagsResponse, *qiscus.Error) {
+	f.record("GetRoomTagsContext", ctx, roomID)
+	if f.GetRoomTagsContextFunc != nil {
+		return f.GetRoomTagsContextFunc(ctx, roomID)
+	}
+	return &multichannel.RoomTagsResponse{}, nil
+}
+
+// CreateRoomTag calls CreateRoomTagFunc
+func (f *FakeMultichannel) CreateRoomTag(req *multichannel.CreateRoomTagReq) (*multichannel.CreateRoomTagResponse, *qiscus.Error) {
+	f.record("CreateRoomTag", req)
+	if f.CreateRoomTagFunc != nil {
+		return f.CreateRoomTagFunc(req)
+	}
+	if f.CreateRoomTagContextFunc != nil {
+		return f.CreateRoomTagContextFunc(context.Background(), req)
+	}
+	return &multichannel.CreateRoomTagResponse{}, nil
+}
+
+// CreateRoomTagContext calls CreateRoomTagContextFunc
+func (f *FakeMultichannel) CreateRoomTagContext(ctx context.Context, req *multichannel.CreateRoomTagReq) (*multichannel.CreateRoomTagResponse, *qiscus.Error) {
+	f.record("CreateRoomTagContext", ctx, req)
+	if f.CreateRoomTagContextFunc != nil {
+		return f.CreateRoomTagContextFunc(ctx, req)
+	}
+	return &multichannel.CreateRoomTagResponse{}, nil
+}
+
+// CreateAdditionalInfoRoomWithReplace calls CreateAdditionalInfoRoomWithReplaceFunc
+func (f *FakeMultichannel) CreateAdditionalInfoRoomWithReplace(roomID string, req *multichannel.CreateAdditionalInfoRoomReq) (*multichannel.CreateAdditionalInfoRoomResponse, *qiscus.Error) {
+	f.record("CreateAdditionalInfoRoomWithReplace", roomID, req)
+	if f.CreateAdditionalInfoRoomWithReplaceFunc != nil {
+		return f.CreateAdditionalInfoRoomWithReplaceFunc(roomID, req)
+	}
+	if f.CreateAdditionalInfoRoomWithReplaceContextFunc != nil {
+		return f.CreateAdditionalInfoRoomWithReplaceContextFunc(context.Background(), roomID, req)
+	}
+	return &multichannel.CreateAdditionalInfoRoomResponse{}, nil
+}
+
+// CreateAdditionalInfoRoomWithReplaceContext calls CreateAdditionalInfoRoomWithReplaceContextFunc
+func (f *FakeMultichannel) CreateAdditionalInfoRoomWithReplaceContext(ctx context.Context, roomID string, req *multichannel.CreateAdditionalInfoRoomReq) (*multichannel.CreateAdditionalInfoRoomResponse, *qiscus.Error) {
+	f.record("CreateAdditionalInfoRoomWithReplaceContext", ctx, roomID, req)
+	if f.CreateAdditionalInfoRoomWithReplaceContextFunc != nil {
+		return f.CreateAdditionalInfoRoomWithReplaceContextFunc(ctx, roomID, req)
+	}
+	return &multichannel.CreateAdditionalInfoRoomResponse{}, nil
+}
+
+// GetAdditionalInfoRoom calls GetAdditionalInfoRoomFunc
+func (f *FakeMultichannel) GetAdditionalInfoRoom(roomID string) (*multichannel.GetAdditionalInfoRoomResponse, *qiscus.Error) {
+	f.record("GetAdditionalInfoRoom", roomID)
+	if f.GetAdditionalInfoRoomFunc != nil {
+		return f.GetAdditionalInfoRoomFunc(roomID)
+	}
+	if f.GetAdditionalInfoRoomContextFunc != nil {
+		return f.GetAdditionalInfoRoomContextFunc(context.Background(), roomID)
+	}
+	return &multichannel.GetAdditionalInfoRoomResponse{}, nil
+}
+
+// GetAdditionalInfoRoomContext calls GetAdditionalInfoRoomContextFunc
+func (f *FakeMultichannel) GetAdditionalInfoRoomContext(ctx context.Context, roomID string) (*multichannel.GetAdditionalInfoRoomResponse, *qiscus.Error) {
+	f.record("GetAdditionalInfoRoomContext", ctx, roomID)
+	if f.GetAdditionalInfoRoomContextFunc != nil {
+		return f.GetAdditionalInfoRoomContextFunc(ctx, roomID)
+	}
+	return &multichannel.GetAdditionalInfoRoomResponse{}, nil
+}
+
+// CreateAdditionalInfoRoom calls CreateAdditionalInfoRoomFunc
+func (f *FakeMultichannel) CreateAdditionalInfoRoom(roomID string, req *multichannel.CreateAdditionalInfoRoomReq) (*multichannel.CreateAdditionalInfoRoomResponse, *qiscus.Error) {
+	f.record("CreateAdditionalInfoRoom", roomID, req)
+	if f.CreateAdditionalInfoRoomFunc != nil {
+		return f.CreateAdditionalInfoRoomFunc(roomID, req)
+	}
+	if f.CreateAdditionalInfoRoomContextFunc != nil {
+		return f.CreateAdditionalInfoRoomContextFunc(context.Background(), roomID, req)
+	}
+	return &multichannel.CreateAdditionalInfoRoomResponse{}, nil
+}
+
+// CreateAdditionalInfoRoomContext calls CreateAdditionalInfoRoomContextFunc
+func (f *FakeMultichannel) CreateAdditionalInfoRoomContext(ctx context.Context, roomID string, req *multichannel.CreateAdditionalInfoRoomReq) (*multichannel.CreateAdditionalInfoRoomResponse, *qiscus.Error) {
+	f.record("CreateAdditionalInfoRoomContext", ctx, roomID, req)
+	if f.CreateAdditionalInfoRoomContextFunc != nil {
+		return f.CreateAdditionalInfoRoomContextFunc(ctx, roomID, req)
+	}
+	return &multichannel.CreateAdditionalInfoRoomResponse{}, nil
+}
+
+// MarkAsResolved calls MarkAsResolvedFunc
+func (f *FakeMultichannel) MarkAsResolved(req *multichannel.MarkAsResolvedReq) (*multichannel.MarkAsResolvedResponse, *qiscus.Error) {
+	f.record("MarkAsResolved", req)
+	if f.MarkAsResolvedFunc != nil {
+		return f.MarkAsResolvedFunc(req)
+	}
+	if f.MarkAsResolvedContextFunc != nil {
+		return f.MarkAsResolvedContextFunc(context.Background(), req)
+	}
+	return &multichannel.MarkAsResolvedResponse{}, nil
+}
+
+// MarkAsResolvedContext calls MarkAsResolvedContextFunc
+func (f *FakeMultichannel) MarkAsResolvedContext(ctx context.Context, req *multichannel.MarkAsResolvedReq) (*multichannel.MarkAsResolvedResponse, *qiscus.Error) {
+	f.record("MarkAsResolvedContext", ctx, req)
+	if f.MarkAsResolvedContextFunc != nil {
+		return f.MarkAsResolvedContextFunc(ctx, req)
+	}
+	return &multichannel.MarkAsResolvedResponse{}, nil
+}
+
+// GetRoomByRoomID calls GetRoomByRoomIDFunc
+func (f *FakeMultichannel) GetRoomByRoomID(roomID string) (*multichannel.GetRoomByRoomIDResponse, *qiscus.Error) {
+	f.record("GetRoomByRoomID", roomID)
+	if f.GetRoomByRoomIDFunc != nil {
+		return f.GetRoomByRoomIDFunc(roomID)
+	}
+	if f.GetRoomByRoomIDContextFunc != nil {
+		return f.GetRoomByRoomIDContextFunc(context.Background(), roomID)
+	}
+	return &multichannel.GetRoomByRoomIDResponse{}, nil
+}
+
+// GetRoomByRoomIDContext calls GetRoomByRoomIDContextFunc
+func (f *FakeMultichannel) GetRoomByRoomIDContext(ctx context.Context, roomID string) (*multichannel.GetRoomByRoomIDResponse, *qiscus.Error) {
+	f.record("GetRoomByRoomIDContext", ctx, roomID)
+	if f.GetRoomByRoomIDContextFunc != nil {
+		return f.GetRoomByRoomIDContextFunc(ctx, roomID)
+	}
+	return &multichannel.GetRoomByRoomIDResponse{}, nil
+}
+
+// SendMessageTextByBot calls SendMessageTextByBotFunc
+func (f *FakeMultichannel) SendMessageTextByBot(req *multichannel.SendMessageTextByBotReq) *qiscus.Error {
+	f.record("SendMessageTextByBot", req)
+	if f.SendMessageTextByBotFunc != nil {
+		return f.SendMessageTextByBotFunc(req)
+	}
+	if f.SendMessageTextByBotContextFunc != nil {
+		return f.SendMessageTextByBotContextFunc(context.Background(), req)
+	}
+	return nil
+}
+
+// SendMessageTextByBotContext calls SendMessageTextByBotContextFunc
+func (f *FakeMultichannel) SendMessageTextByBotContext(ctx context.Context, req *multichannel.SendMessageTextByBotReq) *qiscus.Error {
+	f.record("SendMessageTextByBotContext", ctx, req)
+	if f.SendMessageTextByBotContextFunc != nil {
+		return f.SendMessageTextByBotContextFunc(ctx, req)
+	}
+	return nil
+}
+
+// SendMessageByBot calls SendMessageByBotFunc
+func (f *FakeMultichannel) SendMessageByBot(req *multichannel.SendMessageByBotReq) (*multichannel.SendMessageByBotResponse, *qiscus.Error) {
+	f.record("SendMessageByBot", req)
+	if f.SendMessageByBotFunc != nil {
+		return f.SendMessageByBotFunc(req)
+	}
+	if f.SendMessageByBotContextFunc != nil {
+		return f.SendMessageByBotContextFunc(context.Background(), req)
+	}
+	return &multichannel.SendMessageByBotResponse{}, nil
+}
+
+// SendMessageByBotContext calls SendMessageByBotContextFunc
+func (f *FakeMultichannel) SendMessageByBotContext(ctx context.Context, req *multichannel.SendMessageByBotReq) (*multichannel.SendMessageByBotResponse, *qiscus.Error) {
+	f.record("SendMessageByBotContext", ctx, req)
+	if f.SendMessageByBotContextFunc != nil {
+		return f.SendMessageByBotContextFunc(ctx, req)
+	}
+	return &multichannel.SendMessageByBotResponse{}, nil
+}
+
+// SetToggleBotInRoom calls SetToggleBotInRoomFunc
+func (f *FakeMultichannel) SetToggleBotInRoom(roomID string, isActive bool) (*multichannel.SetToggleBotInRoomResponse, *qiscus.Error) {
+	f.record("SetToggleBotInRoom", roomID, isActive)
+	if f.SetToggleBotInRoomFunc != nil {
+		return f.SetToggleBotInRoomFunc(roomID, isActive)
+	}
+	if f.SetToggleBotInRoomContextFunc != nil {
+		return f.SetToggleBotInRoomContextFunc(context.Background(), roomID, isActive)
+	}
+	return &multichannel.SetToggleBotInRoomResponse{}, nil
+}
+
+// SetToggleBotInRoomContext calls SetToggleBotInRoomContextFunc
+func (f *FakeMultichannel) SetToggleBotInRoomContext(ctx context.Context, roomID string, isActive bool) (*multichannel.SetToggleBotInRoomResponse, *qiscus.Error) {
+	f.record("SetToggleBotInRoomContext", ctx, roomID, isActive)
+	if f.SetToggleBotInRoomContextFunc != nil {
+		return f.SetToggleBotInRoomContextFunc(ctx, roomID, isActive)
+	}
+	return &multichannel.SetToggleBotInRoomResponse{}, nil
+}
+
+// GetAllAgents calls GetAllAgentsFunc
+func (f *FakeMultichannel) GetAllAgents(req *multichannel.GetAllAgentsReq) (*multichannel.GetAllAgentsResponse, *qiscus.Error) {
+	f.record("GetAllAgents", req)
+	if f.GetAllAgentsFunc != nil {
+		return f.GetAllAgentsFunc(req)
+	}
+	if f.GetAllAgentsContextFunc != nil {
+		return f.GetAllAgentsContextFunc(context.Background(), req)
+	}
+	return &multichannel.GetAllAgentsResponse{}, nil
+}
+
+// GetAllAgentsContext calls GetAllAgentsContextFunc
+func (f *FakeMultichannel) GetAllAgentsContext(ctx context.Context, req *multichannel.GetAllAgentsReq) (*multichannel.GetAllAgentsResponse, *qiscus.Error) {
+	f.record("GetAllAgentsContext", ctx, req)
+	if f.GetAllAgentsContextFunc != nil {
+		return f.GetAllAgentsContextFunc(ctx, req)
+	}
+	return &multichannel.GetAllAgentsResponse{}, nil
+}
+
+// AssignAgent calls AssignAgentFunc
+func (f *FakeMultichannel) AssignAgent(req *multichannel.AssignAgentReq) (*multichannel.AssignAgentResponse, *qiscus.Error) {
+	f.record("AssignAgent", req)
+	if f.AssignAgentFunc != nil {
+		return f.AssignAgentFunc(req)
+	}
+	if f.AssignAgentContextFunc != nil {
+		return f.AssignAgentContextFunc(context.Background(), req)
+	}
+	return &multichannel.AssignAgentResponse{}, nil
+}
+
+// AssignAgentContext calls AssignAgentContextFunc
+func (f *FakeMultichannel) AssignAgentContext(ctx context.Context, req *multichannel.AssignAgentReq) (*multichannel.AssignAgentResponse, *qiscus.Error) {
+	f.record("AssignAgentContext", ctx, req)
+	if f.AssignAgentContextFunc != nil {
+		return f.AssignAgentContextFunc(ctx, req)
+	}
+	return &multichannel.AssignAgentResponse{}, nil
+}
+
+// GetAgentsByDivision calls GetAgentsByDivisionFunc
+func (f *FakeMultichannel) GetAgentsByDivision(req *multichannel.GetAgentsByDivisionReq) (*multichannel.GetAgentsByDivisionResponse, *qiscus.Error) {
+	f.record("GetAgentsByDivision", req)
+	if f.GetAgentsByDivisionFunc != nil {
+		return f.GetAgentsByDivisionFunc(req)
+	}
+	if f.GetAgentsByDivisionContextFunc != nil {
+		return f.GetAgentsByDivisionContextFunc(context.Background(), req)
+	}
+	return &multichannel.GetAgentsByDivisionResponse{}, nil
+}
+
+// GetAgentsByDivisionContext calls GetAgentsByDivisionContextFunc
+func (f *FakeMultichannel) GetAgentsByDivisionContext(ctx context.Context, req *multichannel.GetAgentsByDivisionReq) (*multichannel.GetAgentsByDivisionResponse, *qiscus.Error) {
+	f.record("GetAgentsByDivisionContext", ctx, req)
+	if f.GetAgentsByDivisionContextFunc != nil {
+		return f.GetAgentsByDivisionContextFunc(ctx, req)
+	}
+	return &multichannel.GetAgentsByDivisionResponse{}, nil
+}
+
+// GetAllDivision calls GetAllDivisionFunc
+func (f *FakeMultichannel) GetAllDivision(req *multichannel.GetAllDivisionReq) (*multichannel.GetAllDivisionResponse, *qiscus.Error) {
+	f.record("GetAllDivision", req)
+	if f.GetAllDivisionFunc != nil {
+		return f.GetAllDivisionFunc(req)
+	}
+	if f.GetAllDivisionContextFunc != nil {
+		return f.GetAllDivisionContextFunc(context.Background(), req)
+	}
+	return &multichannel.GetAllDivisionResponse{}, nil
+}
+
+// GetAllDivisionContext calls GetAllDivisionContextFunc
+func (f *FakeMultichannel) GetAllDivisionContext(ctx context.Context, req *multichannel.GetAllDivisionReq) (*multichannel.GetAllDivisionResponse, *qiscus.Error) {
+	f.record("GetAllDivisionContext", ctx, req)
+	if f.GetAllDivisionContextFunc != nil {
+		return f.GetAllDivisionContextFunc(ctx, req)
+	}
+	return &multichannel.GetAllDivisionResponse{}, nil
+}
+
+// GetAllChannels calls GetAllChannelsFunc
+func (f *FakeMultichannel) GetAllChannels() (*multichannel.GetAllChannelsResponse, *qiscus.Error) {
+	f.record("GetAllChannels")
+	if f.GetAllChannelsFunc != nil {
+		return f.GetAllChannelsFunc()
+	}
+	if f.GetAllChannelsContextFunc != nil {
+		return f.GetAllChannelsContextFunc(context.Background())
+	}
+	return &multichannel.GetAllChannelsResponse{}, nil
+}
+
+// GetAllChannelsContext calls GetAllChannelsContextFunc
+func (f *FakeMultichannel) GetAllChannelsContext(ctx context.Context) (*multichannel.GetAllChannelsResponse, *qiscus.Error) {
+	f.record("GetAllChannelsContext", ctx)
+	if f.GetAllChannelsContextFunc != nil {
+		return f.GetAllChannelsContextFunc(ctx)
+	}
+	return &multichannel.GetAllChannelsResponse{}, nil
+}
+
+// GetCustomerRooms calls GetCustomerRoomsFunc
+func (f *FakeMultichannel) GetCustomerRooms(req *multichannel.GetCustomerRoomsReq) (*multichannel.GetCustomerRoomsResponse, *qiscus.Error) {
+	f.record("GetCustomerRooms", req)
+	if f.GetCustomerRoomsFunc != nil {
+		return f.GetCustomerRoomsFunc(req)
+	}
+	if f.GetCustomerRoomsContextFunc != nil {
+		return f.GetCustomerRoomsContextFunc(context.Background(), req)
+	}
+	return &multichannel.GetCustomerRoomsResponse{}, nil
+}
+
+// GetCustomerRoomsContext calls GetCustomerRoomsContextFunc
+func (f *FakeMultichannel) GetCustomerRoomsContext(ctx context.Context, req *multichannel.GetCustomerRoomsReq) (*multichannel.GetCustomerRoomsResponse, *qiscus.Error) {
+	f.record("GetCustomerRoomsContext", ctx, req)
+	if f.GetCustomerRoomsContextFunc != nil {
+		return f.GetCustomerRoomsContextFunc(ctx, req)
+	}
+	return &multichannel.GetCustomerRoomsResponse{}, nil
+}
+
+// GetHSMTemplates calls GetHSMTemplatesFunc
+func (f *FakeMultichannel) GetHSMTemplates(req *multichannel.GetHSMTemplatesReq) (*multichannel.GetHSMTemplatesResponse, *qiscus.Error) {
+	f.record("GetHSMTemplates", req)
+	if f.GetHSMTemplatesFunc != nil {
+		return f.GetHSMTemplatesFunc(req)
+	}
+	if f.GetHSMTemplatesContextFunc != nil {
+		return f.GetHSMTemplatesContextFunc(context.Background(), req)
+	}
+	return &multichannel.GetHSMTemplatesResponse{}, nil
+}
+
+// GetHSMTemplatesContext calls GetHSMTemplatesContextFunc
+func (f *FakeMultichannel) GetHSMTemplatesContext(ctx context.Context, req *multichannel.GetHSMTemplatesReq) (*multichannel.GetHSMTemplatesResponse, *qiscus.Error) {
+	f.record("GetHSMTemplatesContext", ctx, req)
+	if f.GetHSMTemplatesContextFunc != nil {
+		return f.GetHSMTemplatesContextFunc(ctx, req)
+	}
+	return &multichannel.GetHSMTemplatesResponse{}, nil
+}
+
+// SendHSM calls SendHSMFunc
+func (f *FakeMultichannel) SendHSM(req *multichannel.SendHSMReq) (*multichannel.SendHSMResponse, *qiscus.Error) {
+	f.record("SendHSM", req)
+	if f.SendHSMFunc != nil {
+		return f.SendHSMFunc(req)
+	}
+	if f.SendHSMContextFunc != nil {
+		return f.SendHSMContextFunc(context.Background(), req)
+	}
+	return &multichannel.SendHSMResponse{}, nil
+}
+
+// SendHSMContext calls SendHSMContextFunc
+func (f *FakeMultichannel) SendHSMContext(ctx context.Context, req *multichannel.SendHSMReq) (*multichannel.SendHSMResponse, *qiscus.Error) {
+	f.record("SendHSMContext", ctx, req)
+	if f.SendHSMContextFunc != nil {
+		return f.SendHSMContextFunc(ctx, req)
+	}
+	return &multichannel.SendHSMResponse{}, nil
+}
+
+// CreateBroadcastJob calls CreateBroadcastJobFunc
+func (f *FakeMultichannel) CreateBroadcastJob(req *multichannel.CreateBroadcastJobReq) (*multichannel.CreateBroadcastJobResponse, *qiscus.Error) {
+	f.record("CreateBroadcastJob", req)
+	if f.CreateBroadcastJobFunc != nil {
+		return f.CreateBroadcastJobFunc(req)
+	}
+	if f.CreateBroadcastJobContextFunc != nil {
+		return f.CreateBroadcastJobContextFunc(context.Background(), req)
+	}
+	return &multichannel.CreateBroadcastJobResponse{}, nil
+}
+
+// CreateBroadcastJobContext calls CreateBroadcastJobContextFunc
+func (f *FakeMultichannel) CreateBroadcastJobContext(ctx context.Context, req *multichannel.CreateBroadcastJobReq) (*multichannel.CreateBroadcastJobResponse, *qiscus.Error) {
+	f.record("CreateBroadcastJobContext", ctx, req)
+	if f.CreateBroadcastJobContextFunc != nil {
+		return f.CreateBroadcastJobContextFunc(ctx, req)
+	}
+	return &multichannel.CreateBroadcastJobResponse{}, nil
+}
+
+// GetBroadcastJob calls GetBroadcastJobFunc
+func (f *FakeMultichannel) GetBroadcastJob(broadcastJobID int) (*multichannel.GetBroadcastJobResponse, *qiscus.Error) {
+	f.record("GetBroadcastJob", broadcastJobID)
+	if f.GetBroadcastJobFunc != nil {
+		return f.GetBroadcastJobFunc(broadcastJobID)
+	}
+	if f.GetBroadcastJobContextFunc != nil {
+		return f.GetBroadcastJobContextFunc(context.Background(), broadcastJobID)
+	}
+	return &multichannel.GetBroadcastJobResponse{}, nil
+}
+
+// GetBroadcastJobContext calls GetBroadcastJobContextFunc
+func (f *FakeMultichannel) GetBroadcastJobContext(ctx context.Context, broadcastJobID int) (*multichannel.GetBroadcastJobResponse, *qiscus.Error) {
+	f.record("GetBroadcastJobContext", ctx, broadcastJobID)
+	if f.GetBroadcastJobContextFunc != nil {
+		return f.GetBroadcastJobContextFunc(ctx, broadcastJobID)
+	}
+	return &multichannel.GetBroadcastJobResponse{}, nil
+}
+
+// GetBroadcastLogs calls GetBroadcastLogsFunc
+func (f *FakeMultichannel) GetBroadcastLogs(req *multichannel.GetBroadcastLogsReq) (*multichannel.GetBroadcastLogsResponse, *qiscus.Error) {
+	f.record("GetBroadcastLogs", req)
+	if f.GetBroadcastLogsFunc != nil {
+		return f.GetBroadcastLogsFunc(req)
+	}
+	if f.GetBroadcastLogsContextFunc != nil {
+		return f.GetBroadcastLogsContextFunc(context.Background(), req)
+	}
+	return &multichannel.GetBroadcastLogsResponse{}, nil
+}
+
+// GetBroadcastLogsContext calls GetBroadcastLogsContextFunc
+func (f *FakeMultichannel) GetBroadcastLogsContext(ctx context.Context, req *multichannel.GetBroadcastLogsReq) (*multichannel.GetBroadcastLogsResponse, *qiscus.Error) {
+	f.record("GetBroadcastLogsContext", ctx, req)
+	if f.GetBroadcastLogsContextFunc != nil {
+		return f.GetBroadcastLogsContextFunc(ctx, req)
+	}
+	return &multichannel.GetBroadcastLogsResponse{}, nil
+}
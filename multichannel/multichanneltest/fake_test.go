@@ -0,0 +1,30 @@
+package multichanneltest_test
+
+import (
+	"testing"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+	"github.com/Qiscus-Integration/qiscus-go/multichannel"
+	"github.com/Qiscus-Integration/qiscus-go/multichannel/multichanneltest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeMultichannel(t *testing.T) {
+	fake := &multichanneltest.FakeMultichannel{
+		AssignAgentFunc: func(req *multichannel.AssignAgentReq) (*multichannel.AssignAgentResponse, *qiscus.Error) {
+			return nil, &qiscus.Error{Message: "agent is offline", StatusCode: 422}
+		},
+	}
+
+	var c multichannel.Multichannel = fake
+
+	_, err := c.AssignAgent(&multichannel.AssignAgentReq{RoomID: roomID, AgentID: "1"})
+	assert.NotNil(t, err)
+	assert.Equal(t, err.GetStatusCode(), 422)
+
+	assert.Nil(t, c.SendMessageTextByBot(&multichannel.SendMessageTextByBotReq{RoomID: roomID, Message: "hello"}))
+
+	calls := fake.CallsTo("AssignAgent")
+	assert.Len(t, calls, 1)
+	assert.Equal(t, calls[0].Args[0].(*multichannel.AssignAgentReq).AgentID, "1")
+}
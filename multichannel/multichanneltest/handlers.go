@@ -0,0 +1,836 @@
+package multichanneltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+)
+
+func (s *Server) routes() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Qiscus-App-Id") != s.AppID || req.Header.Get("Qiscus-Secret-Key") != s.SecretKey {
+			writeError(w, http.StatusUnauthorized, "unauthorized, invalid app id or secret key")
+			return
+		}
+
+		handler := s.route(req.Method, req.URL.Path)
+		if handler == nil {
+			writeError(w, http.StatusNotFound, "route not found")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		handler(w, req)
+	})
+}
+
+// route returns the handler of the method and path, nil when there is none
+func (s *Server) route(method, path string) func(http.ResponseWriter, *http.Request) {
+	get, post := method == http.MethodGet, method == http.MethodPost
+
+	switch {
+	case post && path == "/api/v1/room_tag/create":
+		return s.createRoomTag
+	case get && strings.HasPrefix(path, "/api/v1/room_tag/"):
+		return s.withRoomID(strings.TrimPrefix(path, "/api/v1/room_tag/"), s.getRoomTags)
+	case strings.HasPrefix(path, "/api/v1/qiscus/room/") && strings.HasSuffix(path, "/user_info"):
+		roomID := strings.TrimSuffix(strings.TrimPrefix(path, "/api/v1/qiscus/room/"), "/user_info")
+		if get {
+			return s.withRoomID(roomID, s.getAdditionalInfo)
+		}
+		if post {
+			return s.withRoomID(roomID, s.setAdditionalInfo)
+		}
+	case post && path == "/"+s.AppID+"/bot":
+		return s.sendMessageByBot
+	case post && strings.HasPrefix(path, "/bot/") && strings.HasSuffix(path, "/activate"):
+		return s.withRoomID(strings.TrimSuffix(strings.TrimPrefix(path, "/bot/"), "/activate"), s.toggleBot)
+	case get && path == "/api/v2/admin/agents":
+		return s.getAllAgents
+	case get && path == "/api/v2/admin/agents/by_division":
+		return s.getAgentsByDivision
+	case post && path == "/api/v1/admin/service/assign_agent":
+		return s.assignAgent
+	case post && path == "/api/v1/admin/service/mark_as_resolved":
+		return s.markAsResolved
+	case get && path == "/api/v2/divisions":
+		return s.getAllDivisions
+	case get && path == "/api/v2/channels":
+		return s.getAllChannels
+	case post && path == "/api/v2/customer_rooms":
+		return s.getCustomerRooms
+	case get && strings.HasPrefix(path, "/api/v2/customer_rooms/"):
+		return s.withRoomID(strings.TrimPrefix(path, "/api/v2/customer_rooms/"), s.getCustomerRoom)
+	case get && path == "/api/v2/admin/hsm":
+		return s.getHSMTemplates
+	case post && strings.HasPrefix(path, "/whatsapp/v1/"+s.AppID+"/") && strings.HasSuffix(path, "/messages"):
+		channelID, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(path, "/whatsapp/v1/"+s.AppID+"/"), "/messages"))
+		if err == nil {
+			return s.sendHSM(channelID)
+		}
+	case post && path == "/api/v3/admin/broadcast/client":
+		return s.createBroadcastJob
+	case get && strings.HasPrefix(path, "/api/v2/admin/broadcast_jobs/"):
+		return s.withBroadcastJob(strings.TrimPrefix(path, "/api/v2/admin/broadcast_jobs/"), s.getBroadcastJob)
+	case get && strings.HasPrefix(path, "/api/v2/admin/broadcast_logs/"):
+		return s.withBroadcastJob(strings.TrimPrefix(path, "/api/v2/admin/broadcast_logs/"), s.getBroadcastLogs)
+	}
+
+	return nil
+}
+
+// withRoomID looks up the customer room of the path, or writes a not found error
+func (s *Server) withRoomID(roomID string, fn func(http.ResponseWriter, *http.Request, *CustomerRoom)) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, req *http.Request) {
+		room, ok := s.lookupRoom(w, roomID)
+		if !ok {
+			return
+		}
+		fn(w, req, room)
+	}
+}
+
+func (s *Server) getRoomTags(w http.ResponseWriter, req *http.Request, room *CustomerRoom) {
+	tags := []interface{}{}
+	for _, name := range room.Tags {
+		tags = append(tags, map[string]interface{}{"id": s.tags[name], "name": name})
+	}
+
+	writeJSON(w, map[string]interface{}{"data": tags})
+}
+
+func (s *Server) createRoomTag(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		RoomID string `json:"room_id"`
+		Tag    string `json:"tag"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+	if body.Tag == "" {
+		writeFieldError(w, "tag", "can't be blank")
+		return
+	}
+
+	room, ok := s.lookupRoom(w, body.RoomID)
+	if !ok {
+		return
+	}
+
+	if !contains(room.Tags, body.Tag) {
+		room.Tags = append(room.Tags, body.Tag)
+	}
+
+	writeJSON(w, map[string]interface{}{"data": map[string]interface{}{"id": s.tagID(body.Tag), "name": body.Tag}})
+}
+
+func (s *Server) getAdditionalInfo(w http.ResponseWriter, req *http.Request, room *CustomerRoom) {
+	writeJSON(w, map[string]interface{}{
+		"data": map[string]interface{}{
+			"extras":                    map[string]interface{}{"user_properties": userProperties(room)},
+			"first_initiated":           room.FirstInitiated,
+			"first_agent_response_time": nil,
+			"user_id":                   room.UserID,
+			"channel_id":                room.ChannelID,
+			"is_blocked":                false,
+			"channel_name":              "Qiscus Widget",
+			"channel": map[string]interface{}{
+				"id":        room.ChannelID,
+				"app_code":  s.AppID,
+				"name":      "Qiscus Widget",
+				"is_active": true,
+			},
+		},
+	})
+}
+
+func (s *Server) setAdditionalInfo(w http.ResponseWriter, req *http.Request, room *CustomerRoom) {
+	var body struct {
+		UserProperties []struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		} `json:"user_properties"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+
+	// The given properties replace the existing ones, the first value of a duplicated key wins
+	room.UserProperties = make(map[string]string)
+	room.UserPropertyKeys = nil
+	for _, p := range body.UserProperties {
+		if _, ok := room.UserProperties[p.Key]; ok {
+			continue
+		}
+		room.UserProperties[p.Key] = p.Value
+		room.UserPropertyKeys = append(room.UserPropertyKeys, p.Key)
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"data": map[string]interface{}{
+			"extras":                    map[string]interface{}{"user_properties": userProperties(room)},
+			"first_initiated":           phpDate(room.FirstInitiated),
+			"first_agent_response_time": nil,
+			"user_id":                   room.UserID,
+		},
+	})
+}
+
+func (s *Server) sendMessageByBot(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		SenderEmail string          `json:"sender_email"`
+		Message     string          `json:"message"`
+		RoomID      string          `json:"room_id"`
+		Type        string          `json:"type"`
+		Payload     json.RawMessage `json:"payload"`
+		Extras      json.RawMessage `json:"extras"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+
+	room, ok := s.lookupRoom(w, body.RoomID)
+	if !ok {
+		return
+	}
+
+	if body.Type == "" {
+		body.Type = "text"
+	}
+
+	msg := BotMessage{
+		SenderEmail: body.SenderEmail,
+		Message:     body.Message,
+		Type:        body.Type,
+		Payload:     body.Payload,
+		Extras:      body.Extras,
+		Timestamp:   s.Now().UTC(),
+	}
+	room.BotMessages = append(room.BotMessages, msg)
+
+	writeJSON(w, map[string]interface{}{
+		"results": map[string]interface{}{
+			"comment": map[string]interface{}{
+				"id":        len(room.BotMessages),
+				"message":   msg.Message,
+				"type":      msg.Type,
+				"payload":   msg.Payload,
+				"extras":    msg.Extras,
+				"timestamp": msg.Timestamp.Format(time.RFC3339),
+				"unique_id": fmt.Sprintf("bot-%s-%d", room.RoomID, len(room.BotMessages)),
+				"user": map[string]interface{}{
+					"user_id": msg.SenderEmail,
+				},
+			},
+		},
+		"status": http.StatusOK,
+	})
+}
+
+func (s *Server) toggleBot(w http.ResponseWriter, req *http.Request, room *CustomerRoom) {
+	var body struct {
+		IsActive bool `json:"is_active"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+
+	room.IsHandledByBot = body.IsActive
+
+	writeJSON(w, map[string]interface{}{
+		"data": map[string]interface{}{
+			"id":                       room.ID,
+			"app_id":                   1,
+			"user_id":                  room.UserID,
+			"room_id":                  room.RoomID,
+			"source":                   room.Source,
+			"name":                     room.Name,
+			"extras":                   "{}",
+			"created_at":               formatTime(room.FirstInitiated),
+			"updated_at":               formatTime(s.Now()),
+			"is_handled_by_bot":        room.IsHandledByBot,
+			"start_service_comment_id": "",
+			"is_waiting":               room.IsWaiting,
+			"channel_id":               room.ChannelID,
+			"resolved":                 room.IsResolved,
+		},
+	})
+}
+
+func (s *Server) getAllAgents(w http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	search := strings.ToLower(q.Get("search"))
+	scope := q.Get("scope")
+
+	var agents []*Agent
+	for _, id := range s.agentIDs {
+		a := s.agents[id]
+		if search == "" || s.agentMatches(a, search, scope) {
+			agents = append(agents, a)
+		}
+	}
+
+	page, limit := pagination(req)
+	start, end := pageBounds(len(agents), page, limit)
+
+	writeJSON(w, map[string]interface{}{
+		"data": map[string]interface{}{"agents": s.agentsJSON(agents[start:end])},
+		"meta": map[string]interface{}{
+			"per_page":    limit,
+			"total_count": len(agents),
+		},
+		"status": http.StatusOK,
+	})
+}
+
+func (s *Server) agentMatches(a *Agent, search, scope string) bool {
+	name := strings.Contains(strings.ToLower(a.Name), search)
+	email := strings.Contains(strings.ToLower(a.Email), search)
+
+	switch scope {
+	case "name":
+		return name
+	case "email":
+		return email
+	case "division":
+		for _, id := range a.DivisionIDs {
+			if strings.Contains(strings.ToLower(s.divisions[id].Name), search) {
+				return true
+			}
+		}
+		return false
+	}
+	return name || email
+}
+
+func (s *Server) getAgentsByDivision(w http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	onlyAvailable, _ := strconv.ParseBool(q.Get("is_available"))
+
+	var divisionIDs []int
+	for _, v := range q["division_ids[]"] {
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			writeFieldError(w, "division_ids", "must be a list of numbers")
+			return
+		}
+		divisionIDs = append(divisionIDs, id)
+	}
+
+	var agents []*Agent
+	for _, id := range s.agentIDs {
+		a := s.agents[id]
+		if len(divisionIDs) > 0 && !a.inDivision(divisionIDs) {
+			continue
+		}
+		if onlyAvailable && !a.IsAvailable {
+			continue
+		}
+		agents = append(agents, a)
+	}
+
+	// Sort by customer count, asc by default
+	desc := q.Get("sort") == "desc"
+	sort.SliceStable(agents, func(i, j int) bool {
+		if desc {
+			return agents[i].CurrentCustomerCount > agents[j].CurrentCustomerCount
+		}
+		return agents[i].CurrentCustomerCount < agents[j].CurrentCustomerCount
+	})
+
+	page, limit := pagination(req)
+	start, end := pageBounds(len(agents), page, limit)
+
+	writeJSON(w, map[string]interface{}{
+		"data": s.agentsJSON(agents[start:end]),
+		"meta": pageMeta(page, limit, len(agents)),
+	})
+}
+
+func (s *Server) assignAgent(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		RoomID             string `json:"room_id"`
+		AgentID            string `json:"agent_id"`
+		ReplaceLatestAgent bool   `json:"replace_latest_agent"`
+		MaxAgent           int    `json:"max_agent"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+
+	agentID, err := strconv.Atoi(body.AgentID)
+	if err != nil {
+		writeFieldError(w, "agent_id", "must be a number")
+		return
+	}
+
+	room, ok := s.lookupRoom(w, body.RoomID)
+	if !ok {
+		return
+	}
+	agent, ok := s.agents[agentID]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("agent %d not found", agentID))
+		return
+	}
+	if room.IsResolved {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("room %s is already resolved", room.RoomID))
+		return
+	}
+
+	if !room.hasAgent(agentID) {
+		replace := body.ReplaceLatestAgent && len(room.AgentIDs) > 0
+		count := len(room.AgentIDs)
+		if replace {
+			count--
+		}
+		if body.MaxAgent > 0 && count >= body.MaxAgent {
+			writeError(w, http.StatusUnprocessableEntity, fmt.Sprintf("room %s has reached the max agent of %d", room.RoomID, body.MaxAgent))
+			return
+		}
+
+		if replace {
+			latest := room.AgentIDs[len(room.AgentIDs)-1]
+			room.AgentIDs = room.AgentIDs[:len(room.AgentIDs)-1]
+			s.agents[latest].CurrentCustomerCount--
+		}
+
+		room.AgentIDs = append(room.AgentIDs, agentID)
+		agent.CurrentCustomerCount++
+	}
+	room.IsWaiting = false
+
+	writeJSON(w, map[string]interface{}{
+		"data": map[string]interface{}{"added_agent": s.userJSON(agent)},
+	})
+}
+
+func (s *Server) markAsResolved(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		RoomID        string `json:"room_id"`
+		Notes         string `json:"notes"`
+		LastCommentID string `json:"last_comment_id"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+
+	room, ok := s.lookupRoom(w, body.RoomID)
+	if !ok {
+		return
+	}
+	if room.IsResolved {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("room %s is already resolved", room.RoomID))
+		return
+	}
+
+	now := s.Now().UTC()
+	room.IsResolved = true
+	room.IsWaiting = false
+	room.Notes = body.Notes
+	room.ResolvedAt = now
+	for _, id := range room.AgentIDs {
+		s.agents[id].CurrentCustomerCount--
+	}
+
+	service := map[string]interface{}{
+		"id":               room.ID,
+		"notes":            room.Notes,
+		"is_resolved":      true,
+		"resolved_at":      phpDate(now),
+		"app_id":           1,
+		"room_log_id":      room.ID,
+		"room_id":          room.RoomID,
+		"retrieved_at":     phpDate(room.FirstInitiated),
+		"first_comment_id": "",
+		"last_comment_id":  body.LastCommentID,
+		"created_at":       formatTime(room.FirstInitiated),
+		"updated_at":       formatTime(now),
+	}
+	if len(room.AgentIDs) > 0 {
+		agent := s.agents[room.AgentIDs[len(room.AgentIDs)-1]]
+		service["user_id"] = agent.ID
+		service["user"] = s.userJSON(agent)
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"data": map[string]interface{}{
+			"service": service,
+			"room_info": map[string]interface{}{
+				"room": map[string]interface{}{
+					"room_id":   room.RoomID,
+					"room_name": room.Name,
+					"room_type": "group",
+				},
+			},
+		},
+	})
+}
+
+func (s *Server) getAllDivisions(w http.ResponseWriter, req *http.Request) {
+	page, limit := pagination(req)
+	start, end := pageBounds(len(s.divIDs), page, limit)
+
+	divisions := []interface{}{}
+	for _, id := range s.divIDs[start:end] {
+		d := s.divisions[id]
+		divisions = append(divisions, map[string]interface{}{
+			"app_id":          1,
+			"created_at":      formatTime(d.CreatedAt),
+			"id":              d.ID,
+			"is_default_role": false,
+			"name":            d.Name,
+			"updated_at":      formatTime(d.CreatedAt),
+		})
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"data": divisions,
+		"meta": pageMeta(page, limit, len(s.divIDs)),
+	})
+}
+
+func (s *Server) getAllChannels(w http.ResponseWriter, req *http.Request) {
+	// Every customer room of the fake server comes from a single Qiscus widget channel
+	writeJSON(w, map[string]interface{}{
+		"data": map[string]interface{}{
+			"custom_channels":   []interface{}{},
+			"fb_channels":       []interface{}{},
+			"ig_channels":       []interface{}{},
+			"line_channels":     []interface{}{},
+			"telegram_channels": []interface{}{},
+			"wa_channels":       []interface{}{},
+			"qiscus_channels": []interface{}{
+				map[string]interface{}{
+					"id":        1,
+					"is_active": true,
+					"app_code":  s.AppID,
+					"name":      "Qiscus Widget",
+					"app_id":    1,
+				},
+			},
+		},
+	})
+}
+
+func (s *Server) getCustomerRoom(w http.ResponseWriter, req *http.Request, room *CustomerRoom) {
+	writeJSON(w, map[string]interface{}{
+		"data":   map[string]interface{}{"customer_room": customerRoomJSON(room)},
+		"status": http.StatusOK,
+	})
+}
+
+// getCustomerRooms lists the rooms matching the filters, newest first.
+// The cursor of a page is the ID of its last room.
+func (s *Server) getCustomerRooms(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		Status   string `json:"status"`
+		Channels []struct {
+			Source    string `json:"source"`
+			ChannelID int    `json:"channel_id"`
+		} `json:"channels"`
+		TagIDs       []int  `json:"tag_ids"`
+		AgentIDs     []int  `json:"agent_ids"`
+		StartDate    string `json:"start_date"`
+		EndDate      string `json:"end_date"`
+		Name         string `json:"name"`
+		Limit        int    `json:"limit"`
+		CursorAfter  string `json:"cursor_after"`
+		CursorBefore string `json:"cursor_before"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+
+	if body.Limit <= 0 {
+		body.Limit = 50
+	}
+
+	var start, end time.Time
+	var err error
+	if body.StartDate != "" {
+		if start, err = time.Parse(qiscus.DateTimeLayout, body.StartDate); err != nil {
+			writeFieldError(w, "start_date", "start_date must be formatted as 2006-01-02 15:04:05")
+			return
+		}
+	}
+	if body.EndDate != "" {
+		if end, err = time.Parse(qiscus.DateTimeLayout, body.EndDate); err != nil {
+			writeFieldError(w, "end_date", "end_date must be formatted as 2006-01-02 15:04:05")
+			return
+		}
+	}
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		writeFieldError(w, "end_date", "end_date must be after start_date")
+		return
+	}
+
+	after, _ := strconv.Atoi(body.CursorAfter)
+	before, _ := strconv.Atoi(body.CursorBefore)
+
+	var matched []*CustomerRoom
+	for i := len(s.roomIDs) - 1; i >= 0; i-- {
+		room := s.rooms[s.roomIDs[i]]
+
+		switch {
+		case after > 0 && room.ID >= after,
+			before > 0 && room.ID <= before,
+			!roomHasStatus(room, body.Status),
+			!start.IsZero() && room.FirstInitiated.Before(start),
+			!end.IsZero() && room.FirstInitiated.After(end),
+			body.Name != "" && !strings.Contains(strings.ToLower(room.Name+" "+room.UserID), strings.ToLower(body.Name)):
+			continue
+		}
+
+		if len(body.Channels) > 0 {
+			ok := false
+			for _, c := range body.Channels {
+				ok = ok || (c.Source == room.Source && (c.ChannelID == 0 || c.ChannelID == room.ChannelID))
+			}
+			if !ok {
+				continue
+			}
+		}
+
+		if len(body.TagIDs) > 0 {
+			ok := false
+			for _, name := range room.Tags {
+				for _, id := range body.TagIDs {
+					ok = ok || s.tags[name] == id
+				}
+			}
+			if !ok {
+				continue
+			}
+		}
+
+		if len(body.AgentIDs) > 0 {
+			ok := false
+			for _, id := range body.AgentIDs {
+				ok = ok || room.hasAgent(id)
+			}
+			if !ok {
+				continue
+			}
+		}
+
+		matched = append(matched, room)
+	}
+
+	// A page before the cursor is made of the rooms right before it
+	page, hasPrev, hasNext := matched, after > 0, false
+	if len(page) > body.Limit {
+		if before > 0 {
+			page, hasPrev = page[len(page)-body.Limit:], true
+		} else {
+			page, hasNext = page[:body.Limit], true
+		}
+	}
+	hasNext = hasNext || before > 0
+
+	rooms := []interface{}{}
+	for _, room := range page {
+		rooms = append(rooms, customerRoomJSON(room))
+	}
+
+	meta := map[string]interface{}{"cursor_after": nil, "cursor_before": nil}
+	if len(page) > 0 && hasNext {
+		meta["cursor_after"] = strconv.Itoa(page[len(page)-1].ID)
+	}
+	if len(page) > 0 && hasPrev {
+		meta["cursor_before"] = strconv.Itoa(page[0].ID)
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"data":   map[string]interface{}{"customer_rooms": rooms},
+		"meta":   meta,
+		"status": http.StatusOK,
+	})
+}
+
+// roomHasStatus reports whether the room matches the status filter of Get customer rooms
+func roomHasStatus(room *CustomerRoom, status string) bool {
+	switch status {
+	case "unresolved":
+		return !room.IsResolved
+	case "resolved":
+		return room.IsResolved
+	case "waiting":
+		return room.IsWaiting && !room.IsResolved
+	}
+	return true
+}
+
+func customerRoomJSON(room *CustomerRoom) map[string]interface{} {
+	customerRoom := map[string]interface{}{
+		"channel_id":                 room.ChannelID,
+		"contact_id":                 nil,
+		"id":                         room.ID,
+		"is_handled_by_bot":          room.IsHandledByBot,
+		"is_resolved":                room.IsResolved,
+		"is_waiting":                 room.IsWaiting,
+		"last_customer_comment_text": nil,
+		"last_customer_timestamp":    room.FirstInitiated,
+		"name":                       room.Name,
+		"room_badge":                 "",
+		"room_id":                    room.RoomID,
+		"room_type":                  "individual",
+		"source":                     room.Source,
+		"user_avatar_url":            "",
+		"user_id":                    room.UserID,
+	}
+	if n := len(room.BotMessages); n > 0 {
+		last := room.BotMessages[n-1]
+		customerRoom["last_comment_sender"] = last.SenderEmail
+		customerRoom["last_comment_sender_type"] = "bot"
+		customerRoom["last_comment_text"] = last.Message
+		customerRoom["last_comment_timestamp"] = last.Timestamp
+	}
+	return customerRoom
+}
+
+func (s *Server) lookupRoom(w http.ResponseWriter, roomID string) (*CustomerRoom, bool) {
+	room, ok := s.rooms[roomID]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("room %s not found", roomID))
+		return nil, false
+	}
+	return room, true
+}
+
+func (s *Server) agentsJSON(agents []*Agent) []interface{} {
+	list := []interface{}{}
+	for _, a := range agents {
+		roles := []interface{}{}
+		for _, id := range a.DivisionIDs {
+			roles = append(roles, map[string]interface{}{"id": id, "name": s.divisions[id].Name})
+		}
+
+		list = append(list, map[string]interface{}{
+			"avatar_url":             "",
+			"created_at":             formatTime(a.CreatedAt),
+			"current_customer_count": a.CurrentCustomerCount,
+			"email":                  a.Email,
+			"force_offline":          false,
+			"id":                     a.ID,
+			"is_available":           a.IsAvailable,
+			"last_login":             a.CreatedAt,
+			"name":                   a.Name,
+			"sdk_email":              a.Email,
+			"type":                   a.Type,
+			"type_as_string":         agentType(a.Type),
+			"user_channels":          []interface{}{},
+			"user_roles":             roles,
+		})
+	}
+	return list
+}
+
+// userJSON returns an agent in the user shape of assign agent and mark as resolved responses
+func (s *Server) userJSON(a *Agent) map[string]interface{} {
+	return map[string]interface{}{
+		"id":             a.ID,
+		"name":           a.Name,
+		"email":          a.Email,
+		"created_at":     formatTime(a.CreatedAt),
+		"updated_at":     formatTime(a.CreatedAt),
+		"sdk_email":      a.Email,
+		"is_available":   a.IsAvailable,
+		"type":           a.Type,
+		"app_id":         1,
+		"is_verified":    true,
+		"force_offline":  false,
+		"type_as_string": agentType(a.Type),
+		"assigned_rules": []interface{}{},
+	}
+}
+
+func userProperties(room *CustomerRoom) []interface{} {
+	props := []interface{}{}
+	for _, key := range room.UserPropertyKeys {
+		props = append(props, map[string]interface{}{"key": key, "value": room.UserProperties[key]})
+	}
+	return props
+}
+
+func agentType(t int) string {
+	switch t {
+	case 1:
+		return "admin"
+	case 3:
+		return "supervisor"
+	}
+	return "agent"
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// phpDate returns t in the PHP DateTime shape used by some Multichannel responses
+func phpDate(t time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"date":          t.UTC().Format("2006-01-02 15:04:05.000000"),
+		"timezone_type": 3,
+		"timezone":      "UTC",
+	}
+}
+
+func decodeBody(w http.ResponseWriter, req *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(req.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// pagination returns page and limit query parameters, defaulting to 1 and 20
+func pagination(req *http.Request) (int, int) {
+	page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+	if limit < 1 {
+		limit = 20
+	}
+	return page, limit
+}
+
+func pageBounds(total, page, limit int) (int, int) {
+	start := (page - 1) * limit
+	if start > total {
+		start = total
+	}
+	end := start + limit
+	if end > total {
+		end = total
+	}
+	return start, end
+}
+
+func pageMeta(page, limit, total int) map[string]interface{} {
+	return map[string]interface{}{
+		"limit":      limit,
+		"page":       page,
+		"total":      total,
+		"total_page": (total + limit - 1) / limit,
+	}
+}
@@ -0,0 +1,444 @@
+package multichanneltest
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+)
+
+// HSMTemplate is a WhatsApp HSM template stored in the fake server
+type HSMTemplate struct {
+	ID        int
+	ChannelID int
+	Name      string
+	Namespace string
+	Category  string
+	Details   []HSMDetail
+}
+
+// HSMDetail is a language of a stored HSM template
+type HSMDetail struct {
+	ID       int
+	Language string
+	Content  string // body with {{1}}, {{2}}, ... arguments
+	Approved bool
+}
+
+// SentHSM is a HSM template message sent with SendHSM
+type SentHSM struct {
+	ChannelID  int
+	To         string
+	Namespace  string
+	Name       string
+	Language   string
+	Parameters []string
+	MessageID  string
+	Timestamp  time.Time
+}
+
+// BroadcastJob is a broadcast job stored in the fake server.
+// A job is queued when created, in progress on the first status poll and completed on the next one.
+type BroadcastJob struct {
+	ID               int
+	Name             string
+	ChannelID        int
+	TemplateDetailID int
+	Status           string
+	Logs             []BroadcastLog
+	StartedAt        time.Time
+	CreatedAt        time.Time
+}
+
+// BroadcastLog is the delivery log of a broadcast recipient
+type BroadcastLog struct {
+	ID          int
+	PhoneNumber string
+	Variables   []string
+	Status      string // sent or failed
+	Notes       string
+	MessageID   string
+}
+
+var hsmArgument = regexp.MustCompile(`\{\{\d+\}\}`)
+
+// AddHSMTemplate creates a template of the WhatsApp channel in a single language and returns the IDs
+// of the template and of its language detail. Add more languages with AddHSMDetail.
+func (s *Server) AddHSMTemplate(channelID int, name, language, content string, approved bool) (int, int) {
+	s.mu.Lock()
+	s.nextID++
+	templateID := s.nextID
+	s.hsmTemplates = append(s.hsmTemplates, &HSMTemplate{
+		ID:        templateID,
+		ChannelID: channelID,
+		Name:      name,
+		Namespace: "namespace-" + s.AppID,
+		Category:  "UTILITY",
+	})
+	s.mu.Unlock()
+
+	return templateID, s.AddHSMDetail(templateID, language, content, approved)
+}
+
+// AddHSMDetail adds a language to the template and returns the detail ID, 0 when the template does not exist
+func (s *Server) AddHSMDetail(templateID int, language, content string, approved bool) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := s.hsmTemplate(templateID)
+	if t == nil {
+		return 0
+	}
+
+	s.nextID++
+	t.Details = append(t.Details, HSMDetail{ID: s.nextID, Language: language, Content: content, Approved: approved})
+
+	return s.nextID
+}
+
+// GetSentHSMs returns the HSM template messages sent so far, in order
+func (s *Server) GetSentHSMs() []SentHSM {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]SentHSM(nil), s.sentHSMs...)
+}
+
+// GetBroadcastJob returns a copy of the stored broadcast job
+func (s *Server) GetBroadcastJob(broadcastJobID int) (BroadcastJob, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	j, ok := s.broadcastJobs[broadcastJobID]
+	if !ok {
+		return BroadcastJob{}, false
+	}
+
+	job := *j
+	job.Logs = append([]BroadcastLog(nil), j.Logs...)
+	return job, true
+}
+
+func (s *Server) hsmTemplate(templateID int) *HSMTemplate {
+	for _, t := range s.hsmTemplates {
+		if t.ID == templateID {
+			return t
+		}
+	}
+	return nil
+}
+
+// hsmDetail returns the template and language detail of the detail ID
+func (s *Server) hsmDetail(detailID int) (*HSMTemplate, *HSMDetail) {
+	for _, t := range s.hsmTemplates {
+		for i := range t.Details {
+			if t.Details[i].ID == detailID {
+				return t, &t.Details[i]
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (d *HSMDetail) numberOfArguments() int {
+	return len(hsmArgument.FindAllString(d.Content, -1))
+}
+
+func (s *Server) getHSMTemplates(w http.ResponseWriter, req *http.Request) {
+	page, limit := pagination(req)
+	channelID, _ := strconv.Atoi(req.URL.Query().Get("channel_id"))
+	approved := req.URL.Query().Get("approved") == "true"
+
+	var matched []interface{}
+	for _, t := range s.hsmTemplates {
+		if channelID > 0 && t.ChannelID != channelID {
+			continue
+		}
+
+		details := []interface{}{}
+		for i := range t.Details {
+			d := &t.Details[i]
+			if approved && !d.Approved {
+				continue
+			}
+
+			status := 0
+			if d.Approved {
+				status = 1
+			}
+			details = append(details, map[string]interface{}{
+				"id":                  d.ID,
+				"language":            d.Language,
+				"content":             d.Content,
+				"number_of_arguments": d.numberOfArguments(),
+				"approval_status":     status,
+				"rejection_reason":    "",
+			})
+		}
+		if approved && len(details) == 0 {
+			continue
+		}
+
+		matched = append(matched, map[string]interface{}{
+			"id":          t.ID,
+			"channel_id":  t.ChannelID,
+			"name":        t.Name,
+			"namespace":   t.Namespace,
+			"category":    t.Category,
+			"hsm_details": details,
+		})
+	}
+
+	start, end := pageBounds(len(matched), page, limit)
+
+	writeJSON(w, map[string]interface{}{
+		"data": map[string]interface{}{
+			"hsm_templates": append([]interface{}{}, matched[start:end]...),
+		},
+		"meta": map[string]interface{}{
+			"current_page": page,
+			"total":        len(matched),
+			"total_page":   (len(matched) + limit - 1) / limit,
+		},
+	})
+}
+
+func (s *Server) sendHSM(channelID int) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, req *http.Request) {
+		var body struct {
+			To       string `json:"to"`
+			Type     string `json:"type"`
+			Template struct {
+				Namespace string `json:"namespace"`
+				Name      string `json:"name"`
+				Language  struct {
+					Code string `json:"code"`
+				} `json:"language"`
+				Components []struct {
+					Type       string `json:"type"`
+					Parameters []struct {
+						Text string `json:"text"`
+					} `json:"parameters"`
+				} `json:"components"`
+			} `json:"template"`
+		}
+		if !decodeBody(w, req, &body) {
+			return
+		}
+
+		if body.To == "" {
+			writeFieldError(w, "to", "to is required")
+			return
+		}
+
+		var detail *HSMDetail
+		for _, t := range s.hsmTemplates {
+			if t.ChannelID != channelID || t.Name != body.Template.Name || t.Namespace != body.Template.Namespace {
+				continue
+			}
+			for i := range t.Details {
+				if t.Details[i].Language == body.Template.Language.Code {
+					detail = &t.Details[i]
+				}
+			}
+		}
+		if detail == nil || !detail.Approved {
+			writeFieldError(w, "template", "approved template not found")
+			return
+		}
+
+		var params []string
+		for _, c := range body.Template.Components {
+			if c.Type != "body" {
+				continue
+			}
+			for _, p := range c.Parameters {
+				params = append(params, p.Text)
+			}
+		}
+		if len(params) != detail.numberOfArguments() {
+			writeFieldError(w, "template", fmt.Sprintf("template expects %d parameters, got %d", detail.numberOfArguments(), len(params)))
+			return
+		}
+
+		msg := SentHSM{
+			ChannelID:  channelID,
+			To:         body.To,
+			Namespace:  body.Template.Namespace,
+			Name:       body.Template.Name,
+			Language:   body.Template.Language.Code,
+			Parameters: params,
+			MessageID:  fmt.Sprintf("wamid.%d", len(s.sentHSMs)+1),
+			Timestamp:  s.Now().UTC(),
+		}
+		s.sentHSMs = append(s.sentHSMs, msg)
+
+		writeJSON(w, map[string]interface{}{
+			"contacts": []interface{}{map[string]interface{}{"input": body.To, "wa_id": strings.TrimPrefix(body.To, "+")}},
+			"messages": []interface{}{map[string]interface{}{"id": msg.MessageID}},
+		})
+	}
+}
+
+func (s *Server) createBroadcastJob(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		Name             string `json:"name"`
+		ChannelID        int    `json:"channel_id"`
+		TemplateDetailID int    `json:"template_detail_id"`
+		StartedAt        string `json:"started_at"`
+		Recipients       []struct {
+			PhoneNumber string   `json:"phone_number"`
+			Variables   []string `json:"variables"`
+		} `json:"recipients"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+
+	if len(body.Recipients) == 0 {
+		writeFieldError(w, "recipients", "recipients is empty")
+		return
+	}
+
+	t, detail := s.hsmDetail(body.TemplateDetailID)
+	if detail == nil || !detail.Approved || t.ChannelID != body.ChannelID {
+		writeFieldError(w, "template_detail_id", "approved template not found in channel")
+		return
+	}
+
+	s.nextID++
+	job := &BroadcastJob{
+		ID:               s.nextID,
+		Name:             body.Name,
+		ChannelID:        body.ChannelID,
+		TemplateDetailID: body.TemplateDetailID,
+		Status:           "queued",
+		StartedAt:        s.Now().UTC(),
+		CreatedAt:        s.Now().UTC(),
+	}
+
+	if body.StartedAt != "" {
+		startedAt, err := time.Parse(qiscus.DateTimeLayout, body.StartedAt)
+		if err != nil {
+			writeFieldError(w, "started_at", "started_at must be formatted as 2006-01-02 15:04:05")
+			return
+		}
+		job.StartedAt = startedAt
+	}
+
+	for i, r := range body.Recipients {
+		log := BroadcastLog{ID: i + 1, PhoneNumber: r.PhoneNumber, Variables: r.Variables, Status: "sent"}
+		switch {
+		case !validPhoneNumber(r.PhoneNumber):
+			log.Status, log.Notes = "failed", "invalid phone number"
+		case len(r.Variables) != detail.numberOfArguments():
+			log.Status, log.Notes = "failed", fmt.Sprintf("template expects %d variables, got %d", detail.numberOfArguments(), len(r.Variables))
+		default:
+			log.MessageID = fmt.Sprintf("wamid.%d.%d", job.ID, i+1)
+		}
+		job.Logs = append(job.Logs, log)
+	}
+
+	s.broadcastJobs[job.ID] = job
+
+	writeJSON(w, map[string]interface{}{
+		"data": map[string]interface{}{"broadcast_job": s.broadcastJobJSON(job)},
+	})
+}
+
+func (s *Server) getBroadcastJob(w http.ResponseWriter, req *http.Request, job *BroadcastJob) {
+	switch job.Status {
+	case "queued":
+		job.Status = "in_progress"
+	case "in_progress":
+		job.Status = "completed"
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"data": map[string]interface{}{"broadcast_job": s.broadcastJobJSON(job)},
+	})
+}
+
+func (s *Server) getBroadcastLogs(w http.ResponseWriter, req *http.Request, job *BroadcastJob) {
+	page, limit := pagination(req)
+	start, end := pageBounds(len(job.Logs), page, limit)
+
+	logs := []interface{}{}
+	for _, l := range job.Logs[start:end] {
+		logs = append(logs, map[string]interface{}{
+			"id":           l.ID,
+			"phone_number": l.PhoneNumber,
+			"variables":    l.Variables,
+			"status":       l.Status,
+			"notes":        l.Notes,
+			"message_id":   l.MessageID,
+			"sent_at":      formatTime(job.CreatedAt),
+		})
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"data": map[string]interface{}{"broadcast_logs": logs},
+		"meta": map[string]interface{}{
+			"current_page": page,
+			"total":        len(job.Logs),
+			"total_page":   (len(job.Logs) + limit - 1) / limit,
+		},
+	})
+}
+
+// withBroadcastJob looks up the broadcast job of the path ID, or writes a not found error
+func (s *Server) withBroadcastJob(id string, fn func(http.ResponseWriter, *http.Request, *BroadcastJob)) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, req *http.Request) {
+		jobID, _ := strconv.Atoi(id)
+		job, ok := s.broadcastJobs[jobID]
+		if !ok {
+			writeError(w, http.StatusNotFound, "broadcast job not found")
+			return
+		}
+		fn(w, req, job)
+	}
+}
+
+func (s *Server) broadcastJobJSON(job *BroadcastJob) map[string]interface{} {
+	sent, failed := 0, 0
+	if job.Status == "completed" {
+		for _, l := range job.Logs {
+			if l.Status == "failed" {
+				failed++
+			} else {
+				sent++
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"id":                 job.ID,
+		"name":               job.Name,
+		"channel_id":         job.ChannelID,
+		"template_detail_id": job.TemplateDetailID,
+		"status":             job.Status,
+		"total_recipient":    len(job.Logs),
+		"sent_count":         sent,
+		"failed_count":       failed,
+		"started_at":         formatTime(job.StartedAt),
+		"created_at":         formatTime(job.CreatedAt),
+	}
+}
+
+// validPhoneNumber reports whether phone is digits, with an optional leading +
+func validPhoneNumber(phone string) bool {
+	phone = strings.TrimPrefix(phone, "+")
+	if len(phone) < 6 {
+		return false
+	}
+	for _, r := range phone {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
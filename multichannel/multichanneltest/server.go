@@ -0,0 +1,273 @@
+// Package multichanneltest provides an in-memory fake of Qiscus Multichannel API for integration tests.
+//
+// The fake simulates a Multichannel app with agents, divisions and customer rooms, and keeps a
+// consistent state across calls, e.g. an agent assigned with AssignAgent handles one more customer
+// until the room is resolved:
+//
+//	fake := multichanneltest.NewServer("app-id", "secret-key")
+//	defer fake.Close()
+//
+//	support := fake.AddDivision("Support")
+//	fake.AddAgent("Alice", "alice@mail.com", support)
+//	fake.AddCustomerRoom("123", "customer@mail.com", "Customer")
+//
+//	c := multichannel.NewMultichannel("app-id", "secret-key", multichannel.WithAPIBase(fake.URL))
+package multichanneltest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+)
+
+//go:generate go run ../../internal/cmd/fakegen -dir .. -iface Multichannel -name FakeMultichannel -out fake.go
+
+// Server is an in-memory fake of Qiscus Multichannel API
+type Server struct {
+	*httptest.Server
+
+	AppID     string
+	SecretKey string
+
+	// Now returns the time used for created and updated records, default time.Now
+	Now func() time.Time
+
+	mu        sync.Mutex
+	agents    map[int]*Agent
+	divisions map[int]*Division
+	rooms     map[string]*CustomerRoom
+	tags      map[string]int // tag name to tag ID
+	agentIDs  []int
+	divIDs    []int
+	roomIDs   []string
+	nextID    int
+
+	hsmTemplates  []*HSMTemplate
+	sentHSMs      []SentHSM
+	broadcastJobs map[int]*BroadcastJob
+}
+
+// Agent is an agent stored in the fake server
+type Agent struct {
+	ID                   int
+	Name                 string
+	Email                string
+	IsAvailable          bool
+	Type                 int // 1 admin, 2 agent, 3 supervisor
+	DivisionIDs          []int
+	CurrentCustomerCount int
+	CreatedAt            time.Time
+}
+
+// Division is a division stored in the fake server
+type Division struct {
+	ID        int
+	Name      string
+	CreatedAt time.Time
+}
+
+// CustomerRoom is a customer room stored in the fake server
+type CustomerRoom struct {
+	ID               int
+	RoomID           string
+	UserID           string
+	Name             string
+	Source           string
+	ChannelID        int
+	IsHandledByBot   bool
+	IsWaiting        bool
+	IsResolved       bool
+	Notes            string
+	AgentIDs         []int
+	Tags             []string
+	UserProperties   map[string]string
+	UserPropertyKeys []string
+	BotMessages      []BotMessage
+	FirstInitiated   time.Time
+	ResolvedAt       time.Time
+}
+
+// BotMessage is a message sent with SendMessageTextByBot or SendMessageByBot
+type BotMessage struct {
+	SenderEmail string
+	Message     string
+	Type        string
+	Payload     json.RawMessage
+	Extras      json.RawMessage
+	Timestamp   time.Time
+}
+
+// NewServer starts a fake server accepting the given credentials, call Close when done
+func NewServer(appID, secretKey string) *Server {
+	s := &Server{
+		AppID:     appID,
+		SecretKey: secretKey,
+		Now:       time.Now,
+		agents:    make(map[int]*Agent),
+		divisions: make(map[int]*Division),
+		rooms:     make(map[string]*CustomerRoom),
+		tags:      make(map[string]int),
+
+		broadcastJobs: make(map[int]*BroadcastJob),
+	}
+	s.Server = httptest.NewServer(s.routes())
+
+	return s
+}
+
+// AddDivision creates a division and returns its ID
+func (s *Server) AddDivision(name string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	s.divisions[s.nextID] = &Division{ID: s.nextID, Name: name, CreatedAt: s.Now().UTC()}
+	s.divIDs = append(s.divIDs, s.nextID)
+
+	return s.nextID
+}
+
+// AddAgent creates an available agent in the given divisions and returns its ID
+func (s *Server) AddAgent(name, email string, divisionIDs ...int) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	s.agents[s.nextID] = &Agent{
+		ID:          s.nextID,
+		Name:        name,
+		Email:       email,
+		IsAvailable: true,
+		Type:        2,
+		DivisionIDs: append([]int(nil), divisionIDs...),
+		CreatedAt:   s.Now().UTC(),
+	}
+	s.agentIDs = append(s.agentIDs, s.nextID)
+
+	return s.nextID
+}
+
+// SetAgentAvailable sets the online availability of an agent
+func (s *Server) SetAgentAvailable(agentID int, available bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if a, ok := s.agents[agentID]; ok {
+		a.IsAvailable = available
+	}
+}
+
+// AddCustomerRoom creates an unresolved customer room waiting for an agent, handled by bot
+func (s *Server) AddCustomerRoom(roomID, userID, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	s.rooms[roomID] = &CustomerRoom{
+		ID:             s.nextID,
+		RoomID:         roomID,
+		UserID:         userID,
+		Name:           name,
+		Source:         "qiscus",
+		ChannelID:      1,
+		IsHandledByBot: true,
+		IsWaiting:      true,
+		UserProperties: make(map[string]string),
+		FirstInitiated: s.Now().UTC(),
+	}
+	s.roomIDs = append(s.roomIDs, roomID)
+}
+
+// GetAgent returns a copy of the stored agent
+func (s *Server) GetAgent(agentID int) (Agent, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.agents[agentID]
+	if !ok {
+		return Agent{}, false
+	}
+
+	agent := *a
+	agent.DivisionIDs = append([]int(nil), a.DivisionIDs...)
+	return agent, true
+}
+
+// GetCustomerRoom returns a copy of the stored customer room
+func (s *Server) GetCustomerRoom(roomID string) (CustomerRoom, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.rooms[roomID]
+	if !ok {
+		return CustomerRoom{}, false
+	}
+
+	room := *r
+	room.AgentIDs = append([]int(nil), r.AgentIDs...)
+	room.Tags = append([]string(nil), r.Tags...)
+	room.UserPropertyKeys = append([]string(nil), r.UserPropertyKeys...)
+	room.BotMessages = append([]BotMessage(nil), r.BotMessages...)
+	room.UserProperties = make(map[string]string, len(r.UserProperties))
+	for k, v := range r.UserProperties {
+		room.UserProperties[k] = v
+	}
+	return room, true
+}
+
+func (s *Server) tagID(name string) int {
+	id, ok := s.tags[name]
+	if !ok {
+		s.nextID++
+		id = s.nextID
+		s.tags[name] = id
+	}
+	return id
+}
+
+func (a *Agent) inDivision(divisionIDs []int) bool {
+	for _, id := range divisionIDs {
+		for _, own := range a.DivisionIDs {
+			if id == own {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *CustomerRoom) hasAgent(agentID int) bool {
+	for _, id := range r.AgentIDs {
+		if id == agentID {
+			return true
+		}
+	}
+	return false
+}
+
+// writeJSON writes v with status 200
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes the Multichannel error envelope {"errors": {"message": "..."}, "status": 400}
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeErrors(w, status, map[string]interface{}{"message": message})
+}
+
+// writeFieldError writes a validation error of a request field
+func writeFieldError(w http.ResponseWriter, field, message string) {
+	writeErrors(w, http.StatusUnprocessableEntity, map[string]interface{}{field: []string{message}})
+}
+
+func writeErrors(w http.ResponseWriter, status int, errors map[string]interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"errors": errors,
+		"status": status,
+	})
+}
@@ -0,0 +1,296 @@
+package multichanneltest_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+	"github.com/Qiscus-Integration/qiscus-go/multichannel"
+	"github.com/Qiscus-Integration/qiscus-go/multichannel/multichanneltest"
+	"github.com/Qiscus-Integration/qiscus-go/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	qiscusAppID     = "test-qiscus-app-id"
+	qiscusSecretKey = "test-qiscus-secret-key"
+	roomID          = "123123"
+)
+
+func newClient(t *testing.T) (multichannel.Multichannel, *multichanneltest.Server) {
+	fake := multichanneltest.NewServer(qiscusAppID, qiscusSecretKey)
+	t.Cleanup(fake.Close)
+
+	fake.AddCustomerRoom(roomID, "customer@mail.com", "Customer")
+
+	return multichannel.NewMultichannel(qiscusAppID, qiscusSecretKey, multichannel.WithAPIBase(fake.URL)), fake
+}
+
+func TestAssignAndResolve(t *testing.T) {
+	c, fake := newClient(t)
+
+	support := fake.AddDivision("Support")
+	sales := fake.AddDivision("Sales")
+	alice := fake.AddAgent("Alice", "alice@mail.com", support)
+	bob := fake.AddAgent("Bob", "bob@mail.com", support)
+	fake.AddAgent("Carol", "carol@mail.com", sales)
+	fake.AddCustomerRoom("456", "other@mail.com", "Other")
+
+	// Bob already handles a customer, so Alice is the least busy agent of support
+	_, err := c.AssignAgent(&multichannel.AssignAgentReq{RoomID: "456", AgentID: strconv.Itoa(bob)})
+	assert.Nil(t, err)
+
+	agents, err := c.GetAgentsByDivision(&multichannel.GetAgentsByDivisionReq{DivisionIDs: []string{strconv.Itoa(support)}})
+	assert.Nil(t, err)
+	assert.Len(t, agents.Data, 2)
+	assert.Equal(t, agents.Data[0].ID, alice)
+	assert.Equal(t, agents.Data[1].CurrentCustomerCount, 1)
+
+	assigned, err := c.AssignAgent(&multichannel.AssignAgentReq{RoomID: roomID, AgentID: strconv.Itoa(alice)})
+	assert.Nil(t, err)
+	assert.Equal(t, assigned.Data.AddedAgent.Email, "alice@mail.com")
+
+	room, _ := fake.GetCustomerRoom(roomID)
+	assert.Equal(t, room.AgentIDs, []int{alice})
+	assert.False(t, room.IsWaiting)
+
+	resolved, err := c.MarkAsResolved(&multichannel.MarkAsResolvedReq{RoomID: roomID, Notes: "done"})
+	assert.Nil(t, err)
+	assert.True(t, resolved.Data.Service.IsResolved)
+	assert.Equal(t, resolved.Data.Service.User.ID, alice)
+
+	agent, _ := fake.GetAgent(alice)
+	assert.Equal(t, agent.CurrentCustomerCount, 0)
+
+	_, err = c.AssignAgent(&multichannel.AssignAgentReq{RoomID: roomID, AgentID: strconv.Itoa(bob)})
+	assert.NotNil(t, err)
+	assert.Equal(t, err.GetAPIMessage(), "room 123123 is already resolved")
+}
+
+func TestAssignAgentMaxAgent(t *testing.T) {
+	c, fake := newClient(t)
+
+	alice := fake.AddAgent("Alice", "alice@mail.com")
+	bob := fake.AddAgent("Bob", "bob@mail.com")
+
+	_, err := c.AssignAgent(&multichannel.AssignAgentReq{RoomID: roomID, AgentID: strconv.Itoa(alice), MaxAgent: 1})
+	assert.Nil(t, err)
+
+	_, err = c.AssignAgent(&multichannel.AssignAgentReq{RoomID: roomID, AgentID: strconv.Itoa(bob), MaxAgent: 1})
+	assert.NotNil(t, err)
+	assert.Equal(t, err.GetStatusCode(), 422)
+
+	_, err = c.AssignAgent(&multichannel.AssignAgentReq{RoomID: roomID, AgentID: strconv.Itoa(bob), MaxAgent: 1, ReplaceLatestAgent: true})
+	assert.Nil(t, err)
+
+	room, _ := fake.GetCustomerRoom(roomID)
+	assert.Equal(t, room.AgentIDs, []int{bob})
+
+	_, err = c.AssignAgent(&multichannel.AssignAgentReq{RoomID: roomID, AgentID: "bob"})
+	assert.True(t, errors.Is(err, qiscus.ErrValidation))
+	assert.Equal(t, err.GetFieldErrors()["agent_id"], []string{"must be a number"})
+}
+
+func TestAgentsAndDivisions(t *testing.T) {
+	c, fake := newClient(t)
+
+	support := fake.AddDivision("Support")
+	fake.AddDivision("Sales")
+	fake.AddAgent("Alice", "alice@mail.com", support)
+	fake.AddAgent("Bob", "bob@mail.com")
+
+	agents, err := c.GetAllAgents(&multichannel.GetAllAgentsReq{Search: "support", Scope: "division"})
+	assert.Nil(t, err)
+	assert.Len(t, agents.Data.Agents, 1)
+	assert.Equal(t, agents.Data.Agents[0].UserRoles[0].Name, "Support")
+
+	all, qErr := multichannel.Agents(context.Background(), c, &multichannel.GetAllAgentsReq{Limit: 1}).Collect()
+	assert.Nil(t, qErr)
+	assert.Len(t, all, 2)
+
+	divisions, err := c.GetAllDivision(&multichannel.GetAllDivisionReq{})
+	assert.Nil(t, err)
+	assert.Len(t, divisions.Data, 2)
+	assert.Equal(t, divisions.Meta.Total, 2)
+}
+
+func TestRoomDetails(t *testing.T) {
+	c, fake := newClient(t)
+
+	_, err := c.CreateRoomTag(&multichannel.CreateRoomTagReq{RoomID: roomID, Tag: "vip"})
+	assert.Nil(t, err)
+
+	tags, err := c.GetRoomTags(roomID)
+	assert.Nil(t, err)
+	assert.Len(t, tags.Data, 1)
+	assert.Equal(t, tags.Data[0].Name, "vip")
+
+	_, err = c.CreateAdditionalInfoRoomWithReplace(roomID, &multichannel.CreateAdditionalInfoRoomReq{
+		UserProperties: []multichannel.UserProperty{{Key: "plan", Value: "free"}, {Key: "city", Value: "Jakarta"}},
+	})
+	assert.Nil(t, err)
+
+	_, err = c.CreateAdditionalInfoRoom(roomID, &multichannel.CreateAdditionalInfoRoomReq{
+		UserProperties: []multichannel.UserProperty{{Key: "plan", Value: "pro"}},
+	})
+	assert.Nil(t, err)
+
+	info, err := c.GetAdditionalInfoRoom(roomID)
+	assert.Nil(t, err)
+	assert.Len(t, info.Data.Extras.UserProperties, 2)
+	assert.Equal(t, info.Data.Extras.UserProperties[0].Value, "pro")
+
+	toggled, err := c.SetToggleBotInRoom(roomID, false)
+	assert.Nil(t, err)
+	assert.False(t, toggled.Data.IsHandledByBot)
+
+	err = c.SendMessageTextByBot(&multichannel.SendMessageTextByBotReq{SenderEmail: "bot@mail.com", Message: "hello", RoomID: roomID})
+	assert.Nil(t, err)
+
+	room, err := c.GetRoomByRoomID(roomID)
+	assert.Nil(t, err)
+	assert.Equal(t, room.Data.CustomerRoom.LastCommentText, "hello")
+	assert.False(t, room.Data.CustomerRoom.IsHandledByBot)
+
+	stored, _ := fake.GetCustomerRoom(roomID)
+	assert.Equal(t, stored.Tags, []string{"vip"})
+
+	_, err = c.GetRoomByRoomID("unknown")
+	assert.True(t, errors.Is(err, qiscus.ErrNotFound))
+}
+
+func TestSendMessageByBot(t *testing.T) {
+	c, fake := newClient(t)
+
+	msg, e := sdk.NewLocationMessage("bot@mail.com", roomID, sdk.LocationPayload{Name: "Office", Address: "Jl. Kaliurang", Latitude: -7.75, Longitude: 110.38})
+	assert.Nil(t, e)
+
+	result, err := c.SendMessageByBot(multichannel.NewSendMessageByBotReq(msg))
+	assert.Nil(t, err)
+	assert.Equal(t, result.Comment().Type, sdk.CommentTypeLocation)
+	assert.Equal(t, result.Comment().User.UserID, "bot@mail.com")
+	assert.Equal(t, result.Comment().Payload.(*sdk.LocationPayload).Name, "Office")
+
+	stored, _ := fake.GetCustomerRoom(roomID)
+	assert.Len(t, stored.BotMessages, 1)
+	assert.Equal(t, stored.BotMessages[0].Type, sdk.CommentTypeLocation)
+	assert.JSONEq(t, string(stored.BotMessages[0].Payload), `{"name":"Office","address":"Jl. Kaliurang","latitude":-7.75,"longitude":110.38,"map_url":"http://maps.google.com/?q=-7.75,110.38"}`)
+}
+
+func TestUnauthorized(t *testing.T) {
+	fake := multichanneltest.NewServer(qiscusAppID, qiscusSecretKey)
+	defer fake.Close()
+
+	c := multichannel.NewMultichannel(qiscusAppID, "wrong-secret", multichannel.WithAPIBase(fake.URL))
+	_, err := c.GetAllChannels()
+	assert.True(t, errors.Is(err, qiscus.ErrUnauthorized))
+}
+
+func TestBroadcast(t *testing.T) {
+	c, fake := newClient(t)
+
+	_, detailID := fake.AddHSMTemplate(7, "order_update", "id", "Order {{1}} is {{2}}", true)
+	fake.AddHSMTemplate(7, "promo", "id", "Promo {{1}}", false)
+
+	templates, err := c.GetHSMTemplates(&multichannel.GetHSMTemplatesReq{ChannelID: 7, Approved: true})
+	assert.Nil(t, err)
+	assert.Len(t, templates.Data.HsmTemplates, 1)
+
+	template := templates.Data.HsmTemplates[0]
+	_, err = c.SendHSM(multichannel.NewSendHSMReq(7, "6281234567890", template, "id", "INV-1", "shipped"))
+	assert.Nil(t, err)
+
+	_, err = c.SendHSM(multichannel.NewSendHSMReq(7, "6281234567890", template, "id", "INV-1"))
+	assert.True(t, errors.Is(err, qiscus.ErrValidation))
+
+	sent := fake.GetSentHSMs()
+	assert.Len(t, sent, 1)
+	assert.Equal(t, sent[0].Parameters, []string{"INV-1", "shipped"})
+
+	job, err := c.CreateBroadcastJob(&multichannel.CreateBroadcastJobReq{
+		Name:             "Order update",
+		ChannelID:        7,
+		TemplateDetailID: detailID,
+		Recipients: []multichannel.BroadcastRecipient{
+			{PhoneNumber: "6281234567890", Variables: []string{"INV-1", "shipped"}},
+			{PhoneNumber: "not a phone", Variables: []string{"INV-2", "shipped"}},
+			{PhoneNumber: "6281234567891", Variables: []string{"INV-3"}},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, job.Data.BroadcastJob.Status, multichannel.BroadcastStatusQueued)
+
+	done, err := multichannel.WaitBroadcastJob(context.Background(), c, job.Data.BroadcastJob.ID, time.Millisecond)
+	assert.Nil(t, err)
+	assert.Equal(t, done.Status, multichannel.BroadcastStatusCompleted)
+	assert.Equal(t, done.SentCount, 1)
+	assert.Equal(t, done.FailedCount, 2)
+
+	logs, err := multichannel.BroadcastLogs(context.Background(), c, &multichannel.GetBroadcastLogsReq{BroadcastJobID: done.ID, Limit: 2}).Collect()
+	assert.Nil(t, err)
+	assert.Len(t, logs, 3)
+	assert.Equal(t, logs[1].Notes, "invalid phone number")
+	assert.Equal(t, logs[2].Status, multichannel.BroadcastLogStatusFailed)
+
+	_, err = c.GetBroadcastJob(999)
+	assert.True(t, errors.Is(err, qiscus.ErrNotFound))
+}
+
+func TestGetCustomerRooms(t *testing.T) {
+	c, fake := newClient(t)
+
+	now := time.Date(2021, 9, 20, 7, 0, 0, 0, time.UTC)
+	fake.Now = func() time.Time { return now }
+	for i := 1; i <= 4; i++ {
+		now = now.Add(time.Hour)
+		fake.AddCustomerRoom(strconv.Itoa(i), "customer"+strconv.Itoa(i)+"@mail.com", "Customer "+strconv.Itoa(i))
+	}
+
+	alice := fake.AddAgent("Alice", "alice@mail.com")
+	_, err := c.AssignAgent(&multichannel.AssignAgentReq{RoomID: "1", AgentID: strconv.Itoa(alice)})
+	assert.Nil(t, err)
+	_, err = c.MarkAsResolved(&multichannel.MarkAsResolvedReq{RoomID: "2"})
+	assert.Nil(t, err)
+	tag, err := c.CreateRoomTag(&multichannel.CreateRoomTagReq{RoomID: "3", Tag: "vip"})
+	assert.Nil(t, err)
+
+	roomIDs := func(req *multichannel.GetCustomerRoomsReq) []string {
+		rooms, err := multichannel.CustomerRooms(context.Background(), c, req).Collect()
+		assert.Nil(t, err)
+
+		var ids []string
+		for _, room := range rooms {
+			ids = append(ids, room.RoomID)
+		}
+		return ids
+	}
+
+	// Newest first, across pages
+	assert.Equal(t, roomIDs(&multichannel.GetCustomerRoomsReq{Limit: 2}), []string{"4", "3", "2", "1", roomID})
+
+	assert.Equal(t, roomIDs(&multichannel.GetCustomerRoomsReq{Status: multichannel.CustomerRoomStatusResolved}), []string{"2"})
+	assert.Equal(t, roomIDs(&multichannel.GetCustomerRoomsReq{Status: multichannel.CustomerRoomStatusWaiting}), []string{"4", "3", roomID})
+	assert.Equal(t, roomIDs(&multichannel.GetCustomerRoomsReq{AgentIDs: []int{alice}}), []string{"1"})
+	assert.Equal(t, roomIDs(&multichannel.GetCustomerRoomsReq{TagIDs: []int{tag.Data.ID}}), []string{"3"})
+	assert.Equal(t, roomIDs(&multichannel.GetCustomerRoomsReq{Name: "customer 4"}), []string{"4"})
+	assert.Equal(t, roomIDs(&multichannel.GetCustomerRoomsReq{Channels: []multichannel.CustomerRoomChannel{{Source: "wa"}}}), []string(nil))
+	// Dates are sent in UTC
+	wib := time.FixedZone("WIB", 7*60*60)
+	assert.Equal(t, roomIDs(&multichannel.GetCustomerRoomsReq{
+		StartDate: time.Date(2021, 9, 20, 16, 0, 0, 0, wib),
+		EndDate:   time.Date(2021, 9, 20, 10, 0, 0, 0, time.UTC),
+	}), []string{"3", "2"})
+
+	// Cursor before returns the page right before the cursor
+	page, err := c.GetCustomerRooms(&multichannel.GetCustomerRoomsReq{Limit: 2, CursorBefore: "1"})
+	assert.Nil(t, err)
+	assert.Len(t, page.Data.CustomerRooms, 2)
+	assert.Equal(t, page.Data.CustomerRooms[0].RoomID, "2")
+	assert.Equal(t, page.Meta.CursorBefore, strconv.Itoa(page.Data.CustomerRooms[0].ID))
+
+	_, err = c.GetCustomerRooms(&multichannel.GetCustomerRoomsReq{StartDate: now, EndDate: now.Add(-time.Hour)})
+	assert.True(t, errors.Is(err, qiscus.ErrValidation))
+}
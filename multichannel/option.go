@@ -0,0 +1,56 @@
+package multichannel
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+	"github.com/rs/zerolog"
+)
+
+// Option configures a client created by NewMultichannel
+type Option func(*MultichannelImpl)
+
+// WithHTTPClient sets the HTTP client used by every request of the client, default is qiscus.DefaultHttpClient
+func WithHTTPClient(client *http.Client) Option {
+	return func(m *MultichannelImpl) {
+		m.httpClient = client
+	}
+}
+
+// WithTimeout sets the timeout of the HTTP client used by the client
+func WithTimeout(timeout time.Duration) Option {
+	return func(m *MultichannelImpl) {
+		m.timeout = timeout
+	}
+}
+
+// WithLogger enables the HTTP outbound log of the client and writes it to logger
+func WithLogger(logger zerolog.Logger) Option {
+	return func(m *MultichannelImpl) {
+		m.logger = &logger
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent by the client, default is qiscus.DefaultUserAgent
+func WithUserAgent(userAgent string) Option {
+	return func(m *MultichannelImpl) {
+		m.userAgent = userAgent
+	}
+}
+
+// WithAPIBase sets the API Base URL of the client, default is APIBase
+func WithAPIBase(address string) Option {
+	return func(m *MultichannelImpl) {
+		m.apiBase = address
+	}
+}
+
+// WithRetryPolicy sets the retry policy of the client, default is qiscus.DefaultRetryPolicy.
+// A nil policy disables retry for the client, whatever qiscus.DefaultRetryPolicy is.
+func WithRetryPolicy(policy *qiscus.RetryPolicy) Option {
+	return func(m *MultichannelImpl) {
+		m.retryPolicy = policy
+		m.retryPolicySet = true
+	}
+}
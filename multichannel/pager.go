@@ -0,0 +1,423 @@
+package multichannel
+
+import (
+	"context"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+)
+
+// pageResult is the result of fetching one page
+type pageResult struct {
+	items      interface{}
+	n          int
+	totalPages int // 0 when the endpoint does not tell
+	total      int // total items, used to count the pages when the endpoint only tells the total items
+	err        *qiscus.Error
+}
+
+// pageFunc fetches one page, it must be safe for concurrent use
+type pageFunc func(ctx context.Context, page int) pageResult
+
+// PagerOption configures a pager
+type PagerOption func(*pager)
+
+// WithConcurrency sets the number of pages fetched ahead concurrently once the total pages is known, default 1
+func WithConcurrency(n int) PagerOption {
+	return func(p *pager) {
+		p.concurrency = n
+	}
+}
+
+// pager walks every page of a page based endpoint, prefetching the next pages in order
+type pager struct {
+	ctx         context.Context
+	cancel      context.CancelFunc
+	fetch       pageFunc
+	concurrency int
+	limit       int
+	firstPage   int
+	nextPage    int
+	totalPages  int
+	pageSize    int // largest page returned, the server may return less items than limit
+	pending     []chan pageResult
+	cur         pageResult
+	idx         int
+	done        bool
+	err         *qiscus.Error
+}
+
+func newPager(ctx context.Context, page, limit int, fetch pageFunc, opts []PagerOption) pager {
+	// Set default page
+	if page <= 0 {
+		page = 1
+	}
+
+	p := pager{
+		ctx:         ctx,
+		fetch:       fetch,
+		concurrency: 1,
+		limit:       limit,
+		firstPage:   page,
+		nextPage:    page,
+		idx:         -1,
+	}
+
+	for _, opt := range opts {
+		opt(&p)
+	}
+
+	return p
+}
+
+// next advances to the next item, waiting for the next page when the current one is exhausted
+func (p *pager) next() bool {
+	if p.err != nil || p.done {
+		return false
+	}
+
+	p.idx++
+	for p.idx >= p.cur.n {
+		if !p.schedule() {
+			p.close()
+			return false
+		}
+
+		res := <-p.pending[0]
+		p.pending = p.pending[1:]
+
+		if res.err != nil {
+			p.err = res.err
+			p.close()
+			return false
+		}
+
+		if res.n > p.pageSize {
+			p.pageSize = res.n
+		}
+
+		switch {
+		case res.totalPages > 0:
+			p.totalPages = res.totalPages
+		case res.total > 0:
+			p.totalPages = totalPages(res.total, p.pageSize)
+		}
+
+		p.cur, p.idx = res, 0
+		if res.n == 0 {
+			p.close()
+			return false
+		}
+	}
+
+	return true
+}
+
+// schedule fills the prefetch window, it returns false when there is no page left
+func (p *pager) schedule() bool {
+	window := 1
+	if p.totalPages > 0 && p.concurrency > 1 {
+		window = p.concurrency
+
+		// Only prefetched pages may outlive next, so they are the only ones to cancel on close
+		if p.cancel == nil {
+			p.ctx, p.cancel = context.WithCancel(p.ctx)
+		}
+	}
+
+	for len(p.pending) < window && p.hasMore() {
+		page := p.nextPage
+		p.nextPage++
+
+		ctx, ch := p.ctx, make(chan pageResult, 1)
+		go func() {
+			ch <- p.fetch(ctx, page)
+		}()
+		p.pending = append(p.pending, ch)
+	}
+
+	return len(p.pending) > 0
+}
+
+// hasMore reports whether there is a page left to schedule
+func (p *pager) hasMore() bool {
+	if p.totalPages > 0 {
+		return p.nextPage <= p.totalPages
+	}
+
+	// Without total pages, only a full page may be followed by another one
+	return p.nextPage == p.firstPage || p.cur.n >= p.limit
+}
+
+// close stops the pager and cancels the pages still being prefetched
+func (p *pager) close() {
+	p.done = true
+	if p.cancel != nil {
+		p.cancel()
+	}
+}
+
+// totalPages returns the number of pages for total items with limit items per page
+func totalPages(total, limit int) int {
+	if limit <= 0 {
+		return 0
+	}
+	return (total + limit - 1) / limit
+}
+
+// AgentsPager iterates over every agent returned by GetAllAgents or GetAgentsByDivision. Call Close when leaving the loop before Next returns false,
+// so the pages being prefetched are canceled.
+type AgentsPager struct {
+	pager
+}
+
+// Agents returns a pager over every agent, starting from req.Page
+func Agents(ctx context.Context, c Multichannel, req *GetAllAgentsReq, opts ...PagerOption) *AgentsPager {
+	r := *req
+
+	// Set default limit
+	if r.Limit <= 0 {
+		r.Limit = 20
+	}
+
+	p := &AgentsPager{}
+	p.pager = newPager(ctx, r.Page, r.Limit, func(ctx context.Context, page int) pageResult {
+		pageReq := r
+		pageReq.Page = page
+
+		resp, err := c.GetAllAgentsContext(ctx, &pageReq)
+		if err != nil {
+			return pageResult{err: err}
+		}
+
+		return pageResult{items: resp.Data.Agents, n: len(resp.Data.Agents), total: resp.Meta.TotalCount}
+	}, opts)
+
+	return p
+}
+
+// AgentsByDivision returns a pager over every agent of the divisions, starting from req.Page
+func AgentsByDivision(ctx context.Context, c Multichannel, req *GetAgentsByDivisionReq, opts ...PagerOption) *AgentsPager {
+	r := *req
+
+	// Set default limit
+	if r.Limit <= 0 {
+		r.Limit = 20
+	}
+
+	p := &AgentsPager{}
+	p.pager = newPager(ctx, r.Page, r.Limit, func(ctx context.Context, page int) pageResult {
+		pageReq := r
+		pageReq.Page = page
+
+		resp, err := c.GetAgentsByDivisionContext(ctx, &pageReq)
+		if err != nil {
+			return pageResult{err: err}
+		}
+
+		return pageResult{items: resp.Data, n: len(resp.Data), totalPages: resp.Meta.TotalPage}
+	}, opts)
+
+	return p
+}
+
+// Next advances the pager to the next agent, it returns false when there is no more agent or an error occurred
+func (p *AgentsPager) Next() bool { return p.next() }
+
+// Item returns the current agent
+func (p *AgentsPager) Item() Agent { return p.cur.items.([]Agent)[p.idx] }
+
+// Err returns the error that stopped the pager, if any
+func (p *AgentsPager) Err() *qiscus.Error { return p.err }
+
+// Close stops the pager and cancels the pages being prefetched
+func (p *AgentsPager) Close() { p.close() }
+
+// Collect walks the remaining pages and returns every agent
+func (p *AgentsPager) Collect() ([]Agent, *qiscus.Error) {
+	var agents []Agent
+	for p.Next() {
+		agents = append(agents, p.Item())
+	}
+	return agents, p.Err()
+}
+
+// DivisionsPager iterates over every division returned by GetAllDivision. Call Close when leaving the loop before Next returns false,
+// so the pages being prefetched are canceled.
+type DivisionsPager struct {
+	pager
+}
+
+// Divisions returns a pager over every division, starting from req.Page
+func Divisions(ctx context.Context, c Multichannel, req *GetAllDivisionReq, opts ...PagerOption) *DivisionsPager {
+	r := *req
+
+	// Set default limit
+	if r.Limit <= 0 {
+		r.Limit = 20
+	}
+
+	p := &DivisionsPager{}
+	p.pager = newPager(ctx, r.Page, r.Limit, func(ctx context.Context, page int) pageResult {
+		pageReq := r
+		pageReq.Page = page
+
+		resp, err := c.GetAllDivisionContext(ctx, &pageReq)
+		if err != nil {
+			return pageResult{err: err}
+		}
+
+		return pageResult{items: resp.Data, n: len(resp.Data), totalPages: resp.Meta.TotalPage}
+	}, opts)
+
+	return p
+}
+
+// Next advances the pager to the next division, it returns false when there is no more division or an error occurred
+func (p *DivisionsPager) Next() bool { return p.next() }
+
+// Item returns the current division
+func (p *DivisionsPager) Item() Division { return p.cur.items.([]Division)[p.idx] }
+
+// Err returns the error that stopped the pager, if any
+func (p *DivisionsPager) Err() *qiscus.Error { return p.err }
+
+// Close stops the pager and cancels the pages being prefetched
+func (p *DivisionsPager) Close() { p.close() }
+
+// Collect walks the remaining pages and returns every division
+func (p *DivisionsPager) Collect() ([]Division, *qiscus.Error) {
+	var divisions []Division
+	for p.Next() {
+		divisions = append(divisions, p.Item())
+	}
+	return divisions, p.Err()
+}
+
+// BroadcastLogsPager iterates over every log returned by GetBroadcastLogs. Call Close when leaving the loop before Next returns false,
+// so the pages being prefetched are canceled.
+type BroadcastLogsPager struct {
+	pager
+}
+
+// BroadcastLogs returns a pager over every log of the broadcast job, starting from req.Page
+func BroadcastLogs(ctx context.Context, c Multichannel, req *GetBroadcastLogsReq, opts ...PagerOption) *BroadcastLogsPager {
+	r := *req
+
+	// Set default limit
+	if r.Limit <= 0 {
+		r.Limit = 20
+	}
+
+	p := &BroadcastLogsPager{}
+	p.pager = newPager(ctx, r.Page, r.Limit, func(ctx context.Context, page int) pageResult {
+		pageReq := r
+		pageReq.Page = page
+
+		resp, err := c.GetBroadcastLogsContext(ctx, &pageReq)
+		if err != nil {
+			return pageResult{err: err}
+		}
+
+		logs := resp.Data.BroadcastLogs
+		return pageResult{items: logs, n: len(logs), totalPages: resp.Meta.TotalPage}
+	}, opts)
+
+	return p
+}
+
+// Next advances the pager to the next log, it returns false when there is no more log or an error occurred
+func (p *BroadcastLogsPager) Next() bool { return p.next() }
+
+// Item returns the current log
+func (p *BroadcastLogsPager) Item() BroadcastLog { return p.cur.items.([]BroadcastLog)[p.idx] }
+
+// Err returns the error that stopped the pager, if any
+func (p *BroadcastLogsPager) Err() *qiscus.Error { return p.err }
+
+// Close stops the pager and cancels the pages being prefetched
+func (p *BroadcastLogsPager) Close() { p.close() }
+
+// Collect walks the remaining pages and returns every log
+func (p *BroadcastLogsPager) Collect() ([]BroadcastLog, *qiscus.Error) {
+	var logs []BroadcastLog
+	for p.Next() {
+		logs = append(logs, p.Item())
+	}
+	return logs, p.Err()
+}
+
+// CustomerRoomsPager iterates over every customer room returned by GetCustomerRooms, following cursor_after
+type CustomerRoomsPager struct {
+	ctx     context.Context
+	c       Multichannel
+	req     GetCustomerRoomsReq
+	rooms   []CustomerRoom
+	idx     int
+	fetched bool
+	done    bool
+	err     *qiscus.Error
+}
+
+// CustomerRooms returns a pager over every customer room matching the filters, starting from req.CursorAfter
+func CustomerRooms(ctx context.Context, c Multichannel, req *GetCustomerRoomsReq) *CustomerRoomsPager {
+	r := *req
+	r.CursorBefore = ""
+
+	// Set default limit
+	if r.Limit <= 0 {
+		r.Limit = 50
+	}
+
+	return &CustomerRoomsPager{ctx: ctx, c: c, req: r, idx: -1}
+}
+
+// Next advances the pager to the next customer room, it returns false when there is no more room or an error occurred
+func (p *CustomerRoomsPager) Next() bool {
+	if p.err != nil || p.done {
+		return false
+	}
+
+	p.idx++
+	for p.idx >= len(p.rooms) {
+		// The last page has no cursor to the next one
+		if p.fetched && p.req.CursorAfter == "" {
+			p.done = true
+			return false
+		}
+
+		req := p.req
+		resp, err := p.c.GetCustomerRoomsContext(p.ctx, &req)
+		if err != nil {
+			p.err = err
+			return false
+		}
+
+		p.fetched = true
+		p.rooms, p.idx = resp.Data.CustomerRooms, 0
+		p.req.CursorAfter = resp.Meta.CursorAfter
+		if len(p.rooms) == 0 {
+			p.done = true
+			return false
+		}
+	}
+
+	return true
+}
+
+// Item returns the current customer room
+func (p *CustomerRoomsPager) Item() CustomerRoom { return p.rooms[p.idx] }
+
+// Err returns the error that stopped the pager, if any
+func (p *CustomerRoomsPager) Err() *qiscus.Error { return p.err }
+
+// Close stops the pager
+func (p *CustomerRoomsPager) Close() { p.done = true }
+
+// Collect walks the remaining pages and returns every customer room
+func (p *CustomerRoomsPager) Collect() ([]CustomerRoom, *qiscus.Error) {
+	var rooms []CustomerRoom
+	for p.Next() {
+		rooms = append(rooms, p.Item())
+	}
+	return rooms, p.Err()
+}
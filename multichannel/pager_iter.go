@@ -0,0 +1,68 @@
+//go:build go1.23
+
+package multichannel
+
+import (
+	"iter"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+)
+
+// All returns an iterator over every agent, the iteration stops after yielding an error
+func (p *AgentsPager) All() iter.Seq2[Agent, *qiscus.Error] {
+	return func(yield func(Agent, *qiscus.Error) bool) {
+		defer p.Close()
+		for p.Next() {
+			if !yield(p.Item(), nil) {
+				return
+			}
+		}
+		if p.Err() != nil {
+			yield(Agent{}, p.Err())
+		}
+	}
+}
+
+// All returns an iterator over every division, the iteration stops after yielding an error
+func (p *DivisionsPager) All() iter.Seq2[Division, *qiscus.Error] {
+	return func(yield func(Division, *qiscus.Error) bool) {
+		defer p.Close()
+		for p.Next() {
+			if !yield(p.Item(), nil) {
+				return
+			}
+		}
+		if p.Err() != nil {
+			yield(Division{}, p.Err())
+		}
+	}
+}
+
+// All returns an iterator over every broadcast log, the iteration stops after yielding an error
+func (p *BroadcastLogsPager) All() iter.Seq2[BroadcastLog, *qiscus.Error] {
+	return func(yield func(BroadcastLog, *qiscus.Error) bool) {
+		defer p.Close()
+		for p.Next() {
+			if !yield(p.Item(), nil) {
+				return
+			}
+		}
+		if p.Err() != nil {
+			yield(BroadcastLog{}, p.Err())
+		}
+	}
+}
+
+// All returns an iterator over every customer room, the iteration stops after yielding an error
+func (p *CustomerRoomsPager) All() iter.Seq2[CustomerRoom, *qiscus.Error] {
+	return func(yield func(CustomerRoom, *qiscus.Error) bool) {
+		for p.Next() {
+			if !yield(p.Item(), nil) {
+				return
+			}
+		}
+		if p.Err() != nil {
+			yield(CustomerRoom{}, p.Err())
+		}
+	}
+}
@@ -0,0 +1,40 @@
+//go:build go1.23
+
+package multichannel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomerRoomsAll(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var body GetCustomerRoomsReq
+		json.NewDecoder(req.Body).Decode(&body)
+
+		switch body.CursorAfter {
+		case "":
+			fmt.Fprint(w, `{"data":{"customer_rooms":[{"room_id":"room-2"}]},"meta":{"cursor_after":"2"}}`)
+		case "2":
+			fmt.Fprint(w, `{"data":{"customer_rooms":[{"room_id":"room-1"}]},"meta":{"cursor_after":null}}`)
+		}
+	}))
+
+	defer srv.Close()
+
+	c := NewMultichannel(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	var roomIDs []string
+	for room, err := range CustomerRooms(context.Background(), c, &GetCustomerRoomsReq{Limit: 1}).All() {
+		assert.Nil(t, err)
+		roomIDs = append(roomIDs, room.RoomID)
+	}
+
+	assert.Equal(t, roomIDs, []string{"room-2", "room-1"})
+}
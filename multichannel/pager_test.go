@@ -0,0 +1,182 @@
+package multichannel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAgentsCollect(t *testing.T) {
+	var inFlight, maxInFlight int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.URL.Path, "/api/v2/admin/agents")
+
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+
+		page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+		rsp := fmt.Sprintf(`{"data":{"agents":[{"id":%d},{"id":%d}]},"meta":{"per_page":2,"total_count":10}}`, page*2-1, page*2)
+		fmt.Fprint(w, rsp)
+	}))
+
+	defer srv.Close()
+
+	c := NewMultichannel(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	agents, err := Agents(context.Background(), c, &GetAllAgentsReq{Limit: 2}, WithConcurrency(3)).Collect()
+	assert.Nil(t, err)
+
+	// Agents are returned in page order even when pages are prefetched concurrently
+	var ids []int
+	for _, agent := range agents {
+		ids = append(ids, agent.ID)
+	}
+	assert.Equal(t, ids, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(3))
+}
+
+func TestAgentsCappedLimit(t *testing.T) {
+	var requests int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		assert.Equal(t, req.URL.Query().Get("limit"), "20")
+
+		// The server returns at most 2 agents per page whatever the requested limit
+		page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+		agents := []map[string]int{}
+		for id := page*2 - 1; id <= page*2 && id <= 5; id++ {
+			agents = append(agents, map[string]int{"id": id})
+		}
+
+		data, _ := json.Marshal(agents)
+		fmt.Fprintf(w, `{"data":{"agents":%s},"meta":{"per_page":2,"total_count":5}}`, data)
+	}))
+
+	defer srv.Close()
+
+	c := NewMultichannel(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	agents, err := Agents(context.Background(), c, &GetAllAgentsReq{Limit: 20}, WithConcurrency(2)).Collect()
+	assert.Nil(t, err)
+	assert.Len(t, agents, 5)
+	assert.Equal(t, agents[4].ID, 5)
+	assert.Equal(t, atomic.LoadInt32(&requests), int32(3))
+}
+
+func TestAgentsBreakWithoutPrefetch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"data":{"agents":[{"id":1},{"id":2}]},"meta":{"per_page":2,"total_count":10}}`)
+	}))
+
+	defer srv.Close()
+
+	c := NewMultichannel(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	// Without prefetch nothing is left running, leaving the loop does not need Close
+	p := Agents(context.Background(), c, &GetAllAgentsReq{Limit: 2})
+	for p.Next() {
+		break
+	}
+	assert.Nil(t, p.cancel)
+	assert.Empty(t, p.pending)
+}
+
+func TestAgentsByDivision(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.URL.Path, "/api/v2/admin/agents/by_division")
+		assert.Equal(t, req.URL.Query()["division_ids[]"], []string{"1"})
+
+		page := req.URL.Query().Get("page")
+		rsp := fmt.Sprintf(`{"data":[{"id":%s,"user_roles":[{"id":1,"name":"agent"}]}],"meta":{"limit":1,"page":%s,"total":2,"total_page":2}}`, page, page)
+		fmt.Fprint(w, rsp)
+	}))
+
+	defer srv.Close()
+
+	c := NewMultichannel(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	var ids []int
+	p := AgentsByDivision(context.Background(), c, &GetAgentsByDivisionReq{Limit: 1, DivisionIDs: []string{"1"}})
+	for p.Next() {
+		ids = append(ids, p.Item().ID)
+		assert.Equal(t, p.Item().UserRoles[0].Name, "agent")
+	}
+
+	assert.Nil(t, p.Err())
+	assert.Equal(t, ids, []int{1, 2})
+}
+
+func TestDivisionsError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("page") == "1" {
+			fmt.Fprint(w, `{"data":[{"id":1,"name":"Sales"}],"meta":{"limit":1,"page":1,"total":3,"total_page":3}}`)
+			return
+		}
+
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"errors":"unauthorized"}`)
+	}))
+
+	defer srv.Close()
+
+	c := NewMultichannel(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	divisions, err := Divisions(context.Background(), c, &GetAllDivisionReq{Limit: 1}, WithConcurrency(2)).Collect()
+	assert.NotNil(t, err)
+	assert.Equal(t, err.GetStatusCode(), http.StatusUnauthorized)
+	assert.Equal(t, len(divisions), 1)
+	assert.Equal(t, divisions[0].Name, "Sales")
+}
+
+func TestCustomerRoomsCollect(t *testing.T) {
+	var requests int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.Method, http.MethodPost)
+		assert.Equal(t, req.URL.Path, "/api/v2/customer_rooms")
+		atomic.AddInt32(&requests, 1)
+
+		var body GetCustomerRoomsReq
+		json.NewDecoder(req.Body).Decode(&body)
+		assert.Equal(t, body.Status, CustomerRoomStatusUnresolved)
+		assert.Equal(t, body.Limit, 2)
+
+		switch body.CursorAfter {
+		case "":
+			fmt.Fprint(w, `{"data":{"customer_rooms":[{"id":5},{"id":4}]},"meta":{"cursor_after":"4","cursor_before":null}}`)
+		case "4":
+			fmt.Fprint(w, `{"data":{"customer_rooms":[{"id":3}]},"meta":{"cursor_after":null,"cursor_before":"3"}}`)
+		default:
+			t.Errorf("unexpected cursor %s", body.CursorAfter)
+		}
+	}))
+
+	defer srv.Close()
+
+	c := NewMultichannel(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	rooms, err := CustomerRooms(context.Background(), c, &GetCustomerRoomsReq{Status: CustomerRoomStatusUnresolved, Limit: 2}).Collect()
+	assert.Nil(t, err)
+
+	var ids []int
+	for _, room := range rooms {
+		ids = append(ids, room.ID)
+	}
+	assert.Equal(t, ids, []int{5, 4, 3})
+	assert.Equal(t, atomic.LoadInt32(&requests), int32(2))
+}
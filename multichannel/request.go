@@ -1,5 +1,13 @@
 package multichannel
 
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+	"github.com/Qiscus-Integration/qiscus-go/sdk"
+)
+
 // CreateRoomTagReq is Represent Create room tag request payload
 type CreateRoomTagReq struct {
 	RoomID string `json:"room_id"`
@@ -23,6 +31,30 @@ type SendMessageTextByBotReq struct {
 	RoomID      string
 }
 
+// SendMessageByBotReq is Represent Send message by Bot request payload.
+// Payload is the payload of Type, e.g. *sdk.ButtonsPayload for sdk.CommentTypeButtons.
+type SendMessageByBotReq struct {
+	SenderEmail string      `json:"sender_email"`
+	RoomID      string      `json:"room_id"`
+	Message     string      `json:"message"`
+	Type        string      `json:"type"`
+	Payload     interface{} `json:"payload,omitempty"`
+	Extras      interface{} `json:"extras,omitempty"`
+}
+
+// NewSendMessageByBotReq returns a bot message request of comment request built with the sdk message builders,
+// the user ID of the comment is the sender email
+func NewSendMessageByBotReq(comment *sdk.PostCommentReq) *SendMessageByBotReq {
+	return &SendMessageByBotReq{
+		SenderEmail: comment.UserID,
+		RoomID:      comment.RoomID,
+		Message:     comment.Message,
+		Type:        comment.Type,
+		Payload:     comment.Payload,
+		Extras:      comment.Extras,
+	}
+}
+
 // SetToggleBotInRoomReq is Represent Set toggle room request payload
 type SetToggleBotInRoomReq struct {
 	IsActive bool `json:"is_active"`
@@ -71,3 +103,139 @@ type MarkAsResolvedReq struct {
 	Notes         string `json:"notes"`
 	LastCommentID string `json:"last_comment_id"`
 }
+
+// Customer room status filter
+const (
+	CustomerRoomStatusAll        = "all"
+	CustomerRoomStatusUnresolved = "unresolved"
+	CustomerRoomStatusResolved   = "resolved"
+	CustomerRoomStatusWaiting    = "waiting"
+)
+
+// CustomerRoomChannel is Represent a channel filter of Get customer rooms request
+type CustomerRoomChannel struct {
+	Source    string `json:"source"` // e.g. qiscus, wa, telegram, line, fb, ig or custom
+	ChannelID int    `json:"channel_id"`
+}
+
+// GetCustomerRoomsReq is Represent Get customer rooms request payload
+type GetCustomerRoomsReq struct {
+	Status       string                `json:"status,omitempty"` // default all
+	Channels     []CustomerRoomChannel `json:"channels,omitempty"`
+	TagIDs       []int                 `json:"tag_ids,omitempty"`
+	AgentIDs     []int                 `json:"agent_ids,omitempty"`
+	StartDate    time.Time             `json:"-"`
+	EndDate      time.Time             `json:"-"`
+	Name         string                `json:"name,omitempty"` // search by customer name or user ID
+	Limit        int                   `json:"limit"`          // default 50
+	CursorAfter  string                `json:"cursor_after,omitempty"`
+	CursorBefore string                `json:"cursor_before,omitempty"`
+}
+
+// MarshalJSON encodes the request, with the dates formatted as qiscus.DateTimeLayout in UTC
+func (r GetCustomerRoomsReq) MarshalJSON() ([]byte, error) {
+	type req GetCustomerRoomsReq
+	return json.Marshal(struct {
+		req
+		StartDate string `json:"start_date,omitempty"`
+		EndDate   string `json:"end_date,omitempty"`
+	}{req(r), qiscus.FormatDateTime(r.StartDate), qiscus.FormatDateTime(r.EndDate)})
+}
+
+// GetHSMTemplatesReq is Represent Get HSM templates request payload
+type GetHSMTemplatesReq struct {
+	Page      int  // default 1
+	Limit     int  // default 20
+	ChannelID int  // WhatsApp channel filter, default all channels
+	Approved  bool // only templates with an approved language
+}
+
+// HSMLanguage is Represent language of a HSM template message
+type HSMLanguage struct {
+	Policy string `json:"policy"`
+	Code   string `json:"code"`
+}
+
+// HSMParameter is Represent a parameter of a HSM template component
+type HSMParameter struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// HSMComponent is Represent a component of a HSM template message
+type HSMComponent struct {
+	Type       string         `json:"type"`
+	Parameters []HSMParameter `json:"parameters"`
+}
+
+// HSMTemplateMessage is Represent template of a HSM message
+type HSMTemplateMessage struct {
+	Namespace  string         `json:"namespace"`
+	Name       string         `json:"name"`
+	Language   HSMLanguage    `json:"language"`
+	Components []HSMComponent `json:"components,omitempty"`
+}
+
+// SendHSMReq is Represent Send HSM request payload
+type SendHSMReq struct {
+	ChannelID int                `json:"-"`
+	To        string             `json:"to"`
+	Type      string             `json:"type"` // default template
+	Template  HSMTemplateMessage `json:"template"`
+}
+
+// NewSendHSMReq returns a request sending template in language to the phone number through channel,
+// params are the values of the template body arguments, in order
+func NewSendHSMReq(channelID int, to string, template HSMTemplate, language string, params ...string) *SendHSMReq {
+	req := &SendHSMReq{
+		ChannelID: channelID,
+		To:        to,
+		Type:      "template",
+		Template: HSMTemplateMessage{
+			Namespace: template.Namespace,
+			Name:      template.Name,
+			Language:  HSMLanguage{Policy: "deterministic", Code: language},
+		},
+	}
+
+	if len(params) > 0 {
+		body := HSMComponent{Type: "body"}
+		for _, p := range params {
+			body.Parameters = append(body.Parameters, HSMParameter{Type: "text", Text: p})
+		}
+		req.Template.Components = []HSMComponent{body}
+	}
+
+	return req
+}
+
+// BroadcastRecipient is Represent a recipient of a broadcast
+type BroadcastRecipient struct {
+	PhoneNumber string   `json:"phone_number"`
+	Variables   []string `json:"variables"`
+}
+
+// CreateBroadcastJobReq is Represent Create broadcast job request payload
+type CreateBroadcastJobReq struct {
+	Name             string               `json:"name"`
+	ChannelID        int                  `json:"channel_id"`
+	TemplateDetailID int                  `json:"template_detail_id"` // ID of the template language
+	StartedAt        time.Time            `json:"-"`                  // default now
+	Recipients       []BroadcastRecipient `json:"recipients"`
+}
+
+// MarshalJSON encodes the request, with the start time formatted as qiscus.DateTimeLayout in UTC
+func (r CreateBroadcastJobReq) MarshalJSON() ([]byte, error) {
+	type req CreateBroadcastJobReq
+	return json.Marshal(struct {
+		req
+		StartedAt string `json:"started_at,omitempty"`
+	}{req(r), qiscus.FormatDateTime(r.StartedAt)})
+}
+
+// GetBroadcastLogsReq is Represent Get broadcast logs request payload
+type GetBroadcastLogsReq struct {
+	BroadcastJobID int
+	Page           int // default 1
+	Limit          int // default 20
+}
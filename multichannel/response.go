@@ -1,6 +1,9 @@
 package multichannel
 
-import "time"
+import (
+	"github.com/Qiscus-Integration/qiscus-go"
+	"github.com/Qiscus-Integration/qiscus-go/sdk"
+)
 
 // RoomTagsResponse is Represent Get room tags response payload
 type RoomTagsResponse struct {
@@ -27,17 +30,9 @@ type CreateAdditionalInfoRoomResponse struct {
 				Value string `json:"value"`
 			} `json:"user_properties"`
 		} `json:"extras"`
-		FirstInitiated struct {
-			Date         string `json:"date"`
-			TimezoneType int    `json:"timezone_type"`
-			Timezone     string `json:"timezone"`
-		} `json:"first_initiated"`
-		FirstAgentResponseTime struct {
-			Date         string `json:"date"`
-			TimezoneType int    `json:"timezone_type"`
-			Timezone     string `json:"timezone"`
-		} `json:"first_agent_response_time"`
-		UserID string `json:"user_id"`
+		FirstInitiated         qiscus.Time `json:"first_initiated"`
+		FirstAgentResponseTime qiscus.Time `json:"first_agent_response_time"`
+		UserID                 string      `json:"user_id"`
 	} `json:"data"`
 }
 
@@ -50,18 +45,18 @@ type GetAdditionalInfoRoomResponse struct {
 				Value string `json:"value"`
 			} `json:"user_properties"`
 		} `json:"extras"`
-		FirstInitiated         time.Time `json:"first_initiated"`
-		FirstAgentResponseTime time.Time `json:"first_agent_response_time"`
-		UserID                 string    `json:"user_id"`
-		ChannelID              int       `json:"channel_id"`
-		IsBlocked              bool      `json:"is_blocked"`
-		ChannelName            string    `json:"channel_name"`
+		FirstInitiated         qiscus.Time `json:"first_initiated"`
+		FirstAgentResponseTime qiscus.Time `json:"first_agent_response_time"`
+		UserID                 string      `json:"user_id"`
+		ChannelID              int         `json:"channel_id"`
+		IsBlocked              bool        `json:"is_blocked"`
+		ChannelName            string      `json:"channel_name"`
 		Channel                struct {
 			ID                  int         `json:"id"`
 			AppCode             string      `json:"app_code"`
 			SecretKey           string      `json:"secret_key"`
-			CreatedAt           string      `json:"created_at"`
-			UpdatedAt           string      `json:"updated_at"`
+			CreatedAt           qiscus.Time `json:"created_at"`
+			UpdatedAt           qiscus.Time `json:"updated_at"`
 			IsActive            bool        `json:"is_active"`
 			AppID               int         `json:"app_id"`
 			ForwardURL          interface{} `json:"forward_url"`
@@ -73,6 +68,19 @@ type GetAdditionalInfoRoomResponse struct {
 	} `json:"data"`
 }
 
+// SendMessageByBotResponse is Represent Send message by bot response payload
+type SendMessageByBotResponse struct {
+	Results struct {
+		Comment sdk.Comment `json:"comment"`
+	} `json:"results"`
+	Status int `json:"status"`
+}
+
+// Comment returns the comment sent by bot
+func (r *SendMessageByBotResponse) Comment() sdk.Comment {
+	return r.Results.Comment
+}
+
 // SetToggleBotInRoomResponse is Represent Set toggle bot in room response payload
 type SetToggleBotInRoomResponse struct {
 	Data struct {
@@ -81,8 +89,8 @@ type SetToggleBotInRoomResponse struct {
 		UserID                string      `json:"user_id"`
 		RoomID                string      `json:"room_id"`
 		Source                string      `json:"source"`
-		CreatedAt             string      `json:"created_at"`
-		UpdatedAt             string      `json:"updated_at"`
+		CreatedAt             qiscus.Time `json:"created_at"`
+		UpdatedAt             qiscus.Time `json:"updated_at"`
 		IsHandledByBot        bool        `json:"is_handled_by_bot"`
 		StartServiceCommentID string      `json:"start_service_comment_id"`
 		UserAvatarURL         string      `json:"user_avatar_url"`
@@ -96,9 +104,9 @@ type SetToggleBotInRoomResponse struct {
 		SubSource             interface{} `json:"sub_source"`
 		ChannelID             int         `json:"channel_id"`
 		Resolved              bool        `json:"resolved"`
-		ResolvedTs            interface{} `json:"resolved_ts"`
+		ResolvedTs            qiscus.Time `json:"resolved_ts"`
 		Type                  interface{} `json:"type"`
-		DeletedAt             interface{} `json:"deleted_at"`
+		DeletedAt             qiscus.Time `json:"deleted_at"`
 		CustomerID            interface{} `json:"customer_id"`
 	} `json:"data"`
 }
@@ -111,8 +119,8 @@ type LoginAdminResponse struct {
 			Name                string        `json:"name"`
 			Email               string        `json:"email"`
 			AuthenticationToken string        `json:"authentication_token"`
-			CreatedAt           string        `json:"created_at"`
-			UpdatedAt           string        `json:"updated_at"`
+			CreatedAt           qiscus.Time   `json:"created_at"`
+			UpdatedAt           qiscus.Time   `json:"updated_at"`
 			SdkEmail            string        `json:"sdk_email"`
 			SdkKey              string        `json:"sdk_key"`
 			IsAvailable         bool          `json:"is_available"`
@@ -124,9 +132,9 @@ type LoginAdminResponse struct {
 			BubbleColor         interface{}   `json:"bubble_color"`
 			QismoKey            string        `json:"qismo_key"`
 			DirectLoginToken    interface{}   `json:"direct_login_token"`
-			LastLogin           string        `json:"last_login"`
+			LastLogin           qiscus.Time   `json:"last_login"`
 			ForceOffline        bool          `json:"force_offline"`
-			DeletedAt           interface{}   `json:"deleted_at"`
+			DeletedAt           qiscus.Time   `json:"deleted_at"`
 			TypeAsString        string        `json:"type_as_string"`
 			AssignedRules       []interface{} `json:"assigned_rules"`
 			App                 struct {
@@ -134,8 +142,8 @@ type LoginAdminResponse struct {
 				Name                           string      `json:"name"`
 				AppCode                        string      `json:"app_code"`
 				SecretKey                      string      `json:"secret_key"`
-				CreatedAt                      string      `json:"created_at"`
-				UpdatedAt                      string      `json:"updated_at"`
+				CreatedAt                      qiscus.Time `json:"created_at"`
+				UpdatedAt                      qiscus.Time `json:"updated_at"`
 				BotWebhookURL                  string      `json:"bot_webhook_url"`
 				IsBotEnabled                   bool        `json:"is_bot_enabled"`
 				AllocateAgentWebhookURL        string      `json:"allocate_agent_webhook_url"`
@@ -154,8 +162,8 @@ type LoginAdminResponse struct {
 					ID                     int         `json:"id"`
 					AppID                  int         `json:"app_id"`
 					Widget                 string      `json:"widget"`
-					CreatedAt              string      `json:"created_at"`
-					UpdatedAt              string      `json:"updated_at"`
+					CreatedAt              qiscus.Time `json:"created_at"`
+					UpdatedAt              qiscus.Time `json:"updated_at"`
 					OfflineMessage         interface{} `json:"offline_message"`
 					OnlineMessage          string      `json:"online_message"`
 					Timezone               string      `json:"timezone"`
@@ -164,12 +172,12 @@ type LoginAdminResponse struct {
 					SendOfflineEachMessage bool        `json:"send_offline_each_message"`
 				} `json:"app_config"`
 				AgentRoles []struct {
-					ID            int    `json:"id"`
-					AppID         int    `json:"app_id"`
-					Name          string `json:"name"`
-					IsDefaultRole bool   `json:"is_default_role"`
-					CreatedAt     string `json:"created_at"`
-					UpdatedAt     string `json:"updated_at"`
+					ID            int         `json:"id"`
+					AppID         int         `json:"app_id"`
+					Name          string      `json:"name"`
+					IsDefaultRole bool        `json:"is_default_role"`
+					CreatedAt     qiscus.Time `json:"created_at"`
+					UpdatedAt     qiscus.Time `json:"updated_at"`
 				} `json:"agent_roles"`
 			} `json:"app"`
 		} `json:"user"`
@@ -215,29 +223,41 @@ type LoginAdminResponse struct {
 	} `json:"data"`
 }
 
+// AgentChannel is Represent a channel handled by an agent
+type AgentChannel struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// AgentRole is Represent a role of an agent
+type AgentRole struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// Agent is Represent an agent in Get all agents and Get agents by division response
+type Agent struct {
+	AvatarURL            string         `json:"avatar_url"`
+	CreatedAt            qiscus.Time    `json:"created_at"`
+	CurrentCustomerCount int            `json:"current_customer_count"`
+	Email                string         `json:"email"`
+	ForceOffline         bool           `json:"force_offline"`
+	ID                   int            `json:"id"`
+	IsAvailable          bool           `json:"is_available"`
+	LastLogin            qiscus.Time    `json:"last_login"`
+	Name                 string         `json:"name"`
+	SdkEmail             string         `json:"sdk_email"`
+	SdkKey               string         `json:"sdk_key"`
+	Type                 int            `json:"type"`
+	TypeAsString         string         `json:"type_as_string"`
+	UserChannels         []AgentChannel `json:"user_channels"`
+	UserRoles            []AgentRole    `json:"user_roles"`
+}
+
 // GetAllAgentsResponse is Represent Get all agents response payload
 type GetAllAgentsResponse struct {
 	Data struct {
-		Agents []struct {
-			AvatarURL            string    `json:"avatar_url"`
-			CreatedAt            string    `json:"created_at"`
-			CurrentCustomerCount int       `json:"current_customer_count"`
-			Email                string    `json:"email"`
-			ForceOffline         bool      `json:"force_offline"`
-			ID                   int       `json:"id"`
-			IsAvailable          bool      `json:"is_available"`
-			LastLogin            time.Time `json:"last_login"`
-			Name                 string    `json:"name"`
-			SdkEmail             string    `json:"sdk_email"`
-			SdkKey               string    `json:"sdk_key"`
-			Type                 int       `json:"type"`
-			TypeAsString         string    `json:"type_as_string"`
-			UserChannels         []struct {
-				ID   int    `json:"id"`
-				Name string `json:"name"`
-			} `json:"user_channels"`
-			UserRoles []interface{} `json:"user_roles"`
-		} `json:"agents"`
+		Agents []Agent `json:"agents"`
 	} `json:"data"`
 	Meta struct {
 		PerPage    int `json:"per_page"`
@@ -254,8 +274,8 @@ type AssignAgentResponse struct {
 			Name                string        `json:"name"`
 			Email               string        `json:"email"`
 			AuthenticationToken string        `json:"authentication_token"`
-			CreatedAt           string        `json:"created_at"`
-			UpdatedAt           string        `json:"updated_at"`
+			CreatedAt           qiscus.Time   `json:"created_at"`
+			UpdatedAt           qiscus.Time   `json:"updated_at"`
 			SdkEmail            string        `json:"sdk_email"`
 			SdkKey              string        `json:"sdk_key"`
 			IsAvailable         bool          `json:"is_available"`
@@ -267,9 +287,9 @@ type AssignAgentResponse struct {
 			BubbleColor         interface{}   `json:"bubble_color"`
 			QismoKey            string        `json:"qismo_key"`
 			DirectLoginToken    interface{}   `json:"direct_login_token"`
-			LastLogin           string        `json:"last_login"`
+			LastLogin           qiscus.Time   `json:"last_login"`
 			ForceOffline        bool          `json:"force_offline"`
-			DeletedAt           interface{}   `json:"deleted_at"`
+			DeletedAt           qiscus.Time   `json:"deleted_at"`
 			TypeAsString        string        `json:"type_as_string"`
 			AssignedRules       []interface{} `json:"assigned_rules"`
 		} `json:"added_agent"`
@@ -278,28 +298,7 @@ type AssignAgentResponse struct {
 
 // GetAgentsByDivisionResponse is Represent get agents by divisions response payload
 type GetAgentsByDivisionResponse struct {
-	Data []struct {
-		AvatarURL            string    `json:"avatar_url"`
-		CurrentCustomerCount int       `json:"current_customer_count"`
-		Email                string    `json:"email"`
-		ForceOffline         bool      `json:"force_offline"`
-		ID                   int       `json:"id"`
-		IsAvailable          bool      `json:"is_available"`
-		LastLogin            time.Time `json:"last_login"`
-		Name                 string    `json:"name"`
-		SdkEmail             string    `json:"sdk_email"`
-		SdkKey               string    `json:"sdk_key"`
-		Type                 int       `json:"type"`
-		TypeAsString         string    `json:"type_as_string"`
-		UserChannels         []struct {
-			ID   int    `json:"id"`
-			Name string `json:"name"`
-		} `json:"user_channels"`
-		UserRoles []struct {
-			ID   int    `json:"id"`
-			Name string `json:"name"`
-		} `json:"user_roles"`
-	} `json:"data"`
+	Data []Agent `json:"data"`
 	Meta struct {
 		Limit     int `json:"limit"`
 		Page      int `json:"page"`
@@ -308,16 +307,19 @@ type GetAgentsByDivisionResponse struct {
 	} `json:"meta"`
 }
 
+// Division is Represent a division
+type Division struct {
+	AppID         int         `json:"app_id"`
+	CreatedAt     qiscus.Time `json:"created_at"`
+	ID            int         `json:"id"`
+	IsDefaultRole bool        `json:"is_default_role"`
+	Name          string      `json:"name"`
+	UpdatedAt     qiscus.Time `json:"updated_at"`
+}
+
 // GetAllDivisionResponse is Represent Get all division response payload
 type GetAllDivisionResponse struct {
-	Data []struct {
-		AppID         int    `json:"app_id"`
-		CreatedAt     string `json:"created_at"`
-		ID            int    `json:"id"`
-		IsDefaultRole bool   `json:"is_default_role"`
-		Name          string `json:"name"`
-		UpdatedAt     string `json:"updated_at"`
-	} `json:"data"`
+	Data []Division `json:"data"`
 	Meta struct {
 		Limit     int `json:"limit"`
 		Page      int `json:"page"`
@@ -330,34 +332,26 @@ type GetAllDivisionResponse struct {
 type MarkAsResolvedResponse struct {
 	Data struct {
 		Service struct {
-			Notes      string `json:"notes"`
-			IsResolved bool   `json:"is_resolved"`
-			ResolvedAt struct {
-				Date         string `json:"date"`
-				TimezoneType int    `json:"timezone_type"`
-				Timezone     string `json:"timezone"`
-			} `json:"resolved_at"`
-			UserID      int    `json:"user_id"`
-			AppID       int    `json:"app_id"`
-			RoomLogID   int    `json:"room_log_id"`
-			RoomID      string `json:"room_id"`
-			RetrievedAt struct {
-				Date         string `json:"date"`
-				TimezoneType int    `json:"timezone_type"`
-				Timezone     string `json:"timezone"`
-			} `json:"retrieved_at"`
-			FirstCommentID string `json:"first_comment_id"`
-			LastCommentID  string `json:"last_comment_id"`
-			UpdatedAt      string `json:"updated_at"`
-			CreatedAt      string `json:"created_at"`
-			ID             int    `json:"id"`
+			Notes          string      `json:"notes"`
+			IsResolved     bool        `json:"is_resolved"`
+			ResolvedAt     qiscus.Time `json:"resolved_at"`
+			UserID         int         `json:"user_id"`
+			AppID          int         `json:"app_id"`
+			RoomLogID      int         `json:"room_log_id"`
+			RoomID         string      `json:"room_id"`
+			RetrievedAt    qiscus.Time `json:"retrieved_at"`
+			FirstCommentID string      `json:"first_comment_id"`
+			LastCommentID  string      `json:"last_comment_id"`
+			UpdatedAt      qiscus.Time `json:"updated_at"`
+			CreatedAt      qiscus.Time `json:"created_at"`
+			ID             int         `json:"id"`
 			User           struct {
 				ID                  int           `json:"id"`
 				Name                string        `json:"name"`
 				Email               string        `json:"email"`
 				AuthenticationToken string        `json:"authentication_token"`
-				CreatedAt           string        `json:"created_at"`
-				UpdatedAt           string        `json:"updated_at"`
+				CreatedAt           qiscus.Time   `json:"created_at"`
+				UpdatedAt           qiscus.Time   `json:"updated_at"`
 				SdkEmail            string        `json:"sdk_email"`
 				SdkKey              string        `json:"sdk_key"`
 				IsAvailable         bool          `json:"is_available"`
@@ -369,9 +363,9 @@ type MarkAsResolvedResponse struct {
 				BubbleColor         interface{}   `json:"bubble_color"`
 				QismoKey            string        `json:"qismo_key"`
 				DirectLoginToken    interface{}   `json:"direct_login_token"`
-				LastLogin           string        `json:"last_login"`
+				LastLogin           qiscus.Time   `json:"last_login"`
 				ForceOffline        bool          `json:"force_offline"`
-				DeletedAt           interface{}   `json:"deleted_at"`
+				DeletedAt           qiscus.Time   `json:"deleted_at"`
 				TypeAsString        string        `json:"type_as_string"`
 				AssignedRules       []interface{} `json:"assigned_rules"`
 			} `json:"user"`
@@ -459,7 +453,7 @@ type GetAllChannelsResponse struct {
 			BaseURL                    string      `json:"base_url"`
 			BusinessID                 interface{} `json:"business_id"`
 			BusinessVerificationStatus interface{} `json:"business_verification_status"`
-			CreatedAt                  string      `json:"created_at"`
+			CreatedAt                  qiscus.Time `json:"created_at"`
 			EncodedToken               string      `json:"encoded_token"`
 			ForwardEnabled             bool        `json:"forward_enabled"`
 			ForwardURL                 interface{} `json:"forward_url"`
@@ -473,36 +467,189 @@ type GetAllChannelsResponse struct {
 			PhoneNumberStatus          interface{} `json:"phone_number_status"`
 			Platform                   string      `json:"platform"`
 			ReadEnabled                bool        `json:"read_enabled"`
-			UpdatedAt                  string      `json:"updated_at"`
+			UpdatedAt                  qiscus.Time `json:"updated_at"`
 			UseChannelResponder        bool        `json:"use_channel_responder"`
 		} `json:"wa_channels"`
 	} `json:"data"`
 }
 
+// CustomerRoom is Represent a customer room
+type CustomerRoom struct {
+	ChannelID               int         `json:"channel_id"`
+	ContactID               interface{} `json:"contact_id"`
+	ID                      int         `json:"id"`
+	IsHandledByBot          bool        `json:"is_handled_by_bot"`
+	IsResolved              bool        `json:"is_resolved"`
+	IsWaiting               bool        `json:"is_waiting"`
+	LastCommentSender       string      `json:"last_comment_sender"`
+	LastCommentSenderType   string      `json:"last_comment_sender_type"`
+	LastCommentText         string      `json:"last_comment_text"`
+	LastCommentTimestamp    qiscus.Time `json:"last_comment_timestamp"`
+	LastCustomerCommentText interface{} `json:"last_customer_comment_text"`
+	LastCustomerTimestamp   qiscus.Time `json:"last_customer_timestamp"`
+	Name                    string      `json:"name"`
+	RoomBadge               string      `json:"room_badge"`
+	RoomID                  string      `json:"room_id"`
+	RoomType                string      `json:"room_type"`
+	Source                  string      `json:"source"`
+	UserAvatarURL           string      `json:"user_avatar_url"`
+	UserID                  string      `json:"user_id"`
+}
+
 // GetRoomByRoomIDResponse is Represent Get room by room id response payload
 type GetRoomByRoomIDResponse struct {
 	Data struct {
-		CustomerRoom struct {
-			ChannelID               int         `json:"channel_id"`
-			ContactID               interface{} `json:"contact_id"`
-			ID                      int         `json:"id"`
-			IsHandledByBot          bool        `json:"is_handled_by_bot"`
-			IsResolved              bool        `json:"is_resolved"`
-			IsWaiting               bool        `json:"is_waiting"`
-			LastCommentSender       string      `json:"last_comment_sender"`
-			LastCommentSenderType   string      `json:"last_comment_sender_type"`
-			LastCommentText         string      `json:"last_comment_text"`
-			LastCommentTimestamp    time.Time   `json:"last_comment_timestamp"`
-			LastCustomerCommentText interface{} `json:"last_customer_comment_text"`
-			LastCustomerTimestamp   time.Time   `json:"last_customer_timestamp"`
-			Name                    string      `json:"name"`
-			RoomBadge               string      `json:"room_badge"`
-			RoomID                  string      `json:"room_id"`
-			RoomType                string      `json:"room_type"`
-			Source                  string      `json:"source"`
-			UserAvatarURL           string      `json:"user_avatar_url"`
-			UserID                  string      `json:"user_id"`
-		} `json:"customer_room"`
+		CustomerRoom CustomerRoom `json:"customer_room"`
+	} `json:"data"`
+	Status int `json:"status"`
+}
+
+// GetCustomerRoomsResponse is Represent Get customer rooms response payload
+type GetCustomerRoomsResponse struct {
+	Data struct {
+		CustomerRooms []CustomerRoom `json:"customer_rooms"`
 	} `json:"data"`
+	Meta struct {
+		CursorAfter  string `json:"cursor_after"`
+		CursorBefore string `json:"cursor_before"`
+	} `json:"meta"`
 	Status int `json:"status"`
 }
+
+// HSM approval status of a template language
+const (
+	HSMStatusPending  = 0
+	HSMStatusApproved = 1
+	HSMStatusRejected = 2
+)
+
+// HSMDetail is Represent a language of a HSM template
+type HSMDetail struct {
+	ApprovalStatus    int    `json:"approval_status"`
+	Content           string `json:"content"`
+	ID                int    `json:"id"`
+	Language          string `json:"language"`
+	NumberOfArguments int    `json:"number_of_arguments"`
+	RejectionReason   string `json:"rejection_reason"`
+}
+
+// IsApproved reports whether the template language is approved by WhatsApp
+func (d HSMDetail) IsApproved() bool {
+	return d.ApprovalStatus == HSMStatusApproved
+}
+
+// HSMTemplate is Represent a HSM template
+type HSMTemplate struct {
+	Category   string      `json:"category"`
+	ChannelID  int         `json:"channel_id"`
+	HsmDetails []HSMDetail `json:"hsm_details"`
+	ID         int         `json:"id"`
+	Name       string      `json:"name"`
+	Namespace  string      `json:"namespace"`
+}
+
+// Detail returns the template detail of language, false when there is none
+func (t HSMTemplate) Detail(language string) (HSMDetail, bool) {
+	for _, d := range t.HsmDetails {
+		if d.Language == language {
+			return d, true
+		}
+	}
+	return HSMDetail{}, false
+}
+
+// GetHSMTemplatesResponse is Represent Get HSM templates response payload
+type GetHSMTemplatesResponse struct {
+	Data struct {
+		HsmTemplates []HSMTemplate `json:"hsm_templates"`
+	} `json:"data"`
+	Meta struct {
+		CurrentPage int `json:"current_page"`
+		Total       int `json:"total"`
+		TotalPage   int `json:"total_page"`
+	} `json:"meta"`
+}
+
+// SendHSMResponse is Represent Send HSM response payload
+type SendHSMResponse struct {
+	Contacts []struct {
+		Input string `json:"input"`
+		WaID  string `json:"wa_id"`
+	} `json:"contacts"`
+	Messages []struct {
+		ID string `json:"id"`
+	} `json:"messages"`
+}
+
+// Broadcast job status
+const (
+	BroadcastStatusQueued     = "queued"
+	BroadcastStatusInProgress = "in_progress"
+	BroadcastStatusCompleted  = "completed"
+	BroadcastStatusFailed     = "failed"
+	BroadcastStatusCanceled   = "canceled"
+)
+
+// BroadcastJob is Represent a broadcast job
+type BroadcastJob struct {
+	ChannelID        int         `json:"channel_id"`
+	CreatedAt        qiscus.Time `json:"created_at"`
+	FailedCount      int         `json:"failed_count"`
+	ID               int         `json:"id"`
+	Name             string      `json:"name"`
+	SentCount        int         `json:"sent_count"`
+	StartedAt        qiscus.Time `json:"started_at"`
+	Status           string      `json:"status"`
+	TemplateDetailID int         `json:"template_detail_id"`
+	TotalRecipient   int         `json:"total_recipient"`
+}
+
+// Done reports whether the broadcast job is finished, either completed, failed or canceled
+func (j BroadcastJob) Done() bool {
+	return j.Status == BroadcastStatusCompleted || j.Status == BroadcastStatusFailed || j.Status == BroadcastStatusCanceled
+}
+
+// CreateBroadcastJobResponse is Represent Create broadcast job response payload
+type CreateBroadcastJobResponse struct {
+	Data struct {
+		BroadcastJob BroadcastJob `json:"broadcast_job"`
+	} `json:"data"`
+}
+
+// GetBroadcastJobResponse is Represent Get broadcast job response payload
+type GetBroadcastJobResponse struct {
+	Data struct {
+		BroadcastJob BroadcastJob `json:"broadcast_job"`
+	} `json:"data"`
+}
+
+// Broadcast log status of a recipient
+const (
+	BroadcastLogStatusSent      = "sent"
+	BroadcastLogStatusDelivered = "delivered"
+	BroadcastLogStatusRead      = "read"
+	BroadcastLogStatusFailed    = "failed"
+)
+
+// BroadcastLog is Represent the delivery log of a broadcast recipient
+type BroadcastLog struct {
+	ID          int         `json:"id"`
+	MessageID   string      `json:"message_id"`
+	Notes       string      `json:"notes"`
+	PhoneNumber string      `json:"phone_number"`
+	SentAt      qiscus.Time `json:"sent_at"`
+	Status      string      `json:"status"`
+	Variables   []string    `json:"variables"`
+}
+
+// GetBroadcastLogsResponse is Represent Get broadcast logs response payload
+type GetBroadcastLogsResponse struct {
+	Data struct {
+		BroadcastLogs []BroadcastLog `json:"broadcast_logs"`
+	} `json:"data"`
+	Meta struct {
+		CurrentPage int `json:"current_page"`
+		Total       int `json:"total"`
+		TotalPage   int `json:"total_page"`
+	} `json:"meta"`
+}
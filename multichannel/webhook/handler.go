@@ -0,0 +1,183 @@
+// Package webhook provides http.Handler constructors for the webhooks configured in Qiscus Multichannel:
+// custom agent allocation, mark as resolved and bot webhook.
+//
+// Qiscus Multichannel only lets you configure the webhook URL, so the optional secret is expected
+// in the "secret" query parameter of the URL, or in the X-Qiscus-Webhook-Secret header when sent by a proxy:
+//
+//	h := webhook.NewMarkAsResolvedHandler(func(ctx context.Context, e *webhook.MarkAsResolvedEvent) error {
+//		return nil
+//	}, webhook.WithSecret("my-secret"))
+//	http.Handle("/qiscus/resolved", h) // configured as https://example.com/qiscus/resolved?secret=my-secret
+package webhook
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Qiscus-Integration/qiscus-go/multichannel"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// SecretQueryParam is the query parameter holding the webhook secret
+	SecretQueryParam = "secret"
+
+	// SecretHeader is the header holding the webhook secret
+	SecretHeader = "X-Qiscus-Webhook-Secret"
+
+	// MaxBodySize is the maximum size of webhook payload accepted by the handlers
+	MaxBodySize = 10 << 20
+)
+
+// Option configures a webhook handler
+type Option func(*options)
+
+type options struct {
+	secret string
+	logger *zerolog.Logger
+}
+
+// WithSecret rejects requests that do not carry secret, see SecretQueryParam and SecretHeader
+func WithSecret(secret string) Option {
+	return func(o *options) {
+		o.secret = secret
+	}
+}
+
+// WithLogger sets the logger of callback and API errors, default is the global zerolog logger.
+// The errors are logged instead of being sent back in the response.
+func WithLogger(logger zerolog.Logger) Option {
+	return func(o *options) {
+		o.logger = &logger
+	}
+}
+
+// AgentAllocationFunc returns the agent to assign to the room, or nil to leave the room unassigned
+type AgentAllocationFunc func(ctx context.Context, req *AgentAllocationRequest) (*AgentAssignment, error)
+
+// MarkAsResolvedFunc is a callback for mark as resolved webhook
+type MarkAsResolvedFunc func(ctx context.Context, event *MarkAsResolvedEvent) error
+
+// BotFunc is a callback for bot webhook
+type BotFunc func(ctx context.Context, msg *BotMessage) error
+
+// NewAgentAllocationHandler creates a handler for custom agent allocation webhook.
+// The agent returned by fn is assigned to the room with client.AssignAgent.
+// It responds 500 when fn fails and 502 when the agent cannot be assigned, the error is logged, see WithLogger.
+func NewAgentAllocationHandler(client multichannel.Multichannel, fn AgentAllocationFunc, opts ...Option) http.Handler {
+	return newHandler(opts, func(w http.ResponseWriter, r *http.Request, logger *zerolog.Logger) {
+		req := &AgentAllocationRequest{}
+		if !decode(w, r, req) {
+			return
+		}
+
+		assignment, err := fn(r.Context(), req)
+		if err != nil {
+			logger.Error().Err(err).Str("room_id", req.RoomID).Msg("qiscus agent allocation callback failed")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		if assignment == nil {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		_, e := client.AssignAgentContext(r.Context(), &multichannel.AssignAgentReq{
+			RoomID:             req.RoomID,
+			AgentID:            assignment.AgentID,
+			ReplaceLatestAgent: assignment.ReplaceLatestAgent,
+			MaxAgent:           assignment.MaxAgent,
+		})
+		if e != nil {
+			logger.Error().Err(e).Str("room_id", req.RoomID).Msg("qiscus assign agent failed")
+			http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// NewMarkAsResolvedHandler creates a handler for mark as resolved webhook
+func NewMarkAsResolvedHandler(fn MarkAsResolvedFunc, opts ...Option) http.Handler {
+	return newHandler(opts, func(w http.ResponseWriter, r *http.Request, logger *zerolog.Logger) {
+		event := &MarkAsResolvedEvent{}
+		if !decode(w, r, event) {
+			return
+		}
+
+		if err := fn(r.Context(), event); err != nil {
+			logger.Error().Err(err).Str("room_id", event.Service.RoomID).Msg("qiscus mark as resolved callback failed")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// NewBotHandler creates a handler for bot webhook
+func NewBotHandler(fn BotFunc, opts ...Option) http.Handler {
+	return newHandler(opts, func(w http.ResponseWriter, r *http.Request, logger *zerolog.Logger) {
+		msg := &BotMessage{}
+		if !decode(w, r, msg) {
+			return
+		}
+		msg.Payload.Type = msg.Type
+
+		if err := fn(r.Context(), msg); err != nil {
+			logger.Error().Err(err).Str("room_id", msg.Payload.Room.RoomID).Msg("qiscus bot callback failed")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// newHandler wraps next with method and secret verification
+func newHandler(opts []Option, next func(w http.ResponseWriter, r *http.Request, logger *zerolog.Logger)) http.Handler {
+	o := &options{logger: &log.Logger}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if o.secret != "" && !verifySecret(r, o.secret) {
+			http.Error(w, "invalid webhook secret", http.StatusUnauthorized)
+			return
+		}
+
+		next(w, r, o.logger)
+	})
+}
+
+func verifySecret(r *http.Request, secret string) bool {
+	given := r.Header.Get(SecretHeader)
+	if given == "" {
+		given = r.URL.Query().Get(SecretQueryParam)
+	}
+
+	return subtle.ConstantTimeCompare([]byte(given), []byte(secret)) == 1
+}
+
+// decode decodes the request body into v, it writes 400 response and returns false when the payload is invalid
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, MaxBodySize)).Decode(v); err != nil {
+		http.Error(w, fmt.Sprintf("invalid webhook payload: %s", err.Error()), http.StatusBadRequest)
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,166 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Qiscus-Integration/qiscus-go/multichannel"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+const roomID = "123123"
+
+func TestNewAgentAllocationHandler(t *testing.T) {
+	var assigned multichannel.AssignAgentReq
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.URL.Path, "/api/v1/admin/service/assign_agent")
+		assert.Nil(t, json.NewDecoder(req.Body).Decode(&assigned))
+
+		fmt.Fprint(w, `{"data":{"added_agent":{"id":2}}}`)
+	}))
+
+	defer srv.Close()
+
+	client := multichannel.NewMultichannel("app-id", "secret-key", multichannel.WithAPIBase(srv.URL))
+	h := NewAgentAllocationHandler(client, func(ctx context.Context, req *AgentAllocationRequest) (*AgentAssignment, error) {
+		assert.Equal(t, req.RoomID, roomID)
+		assert.Equal(t, req.CandidateAgent.ID, 1)
+
+		// Pick another agent than the candidate
+		return &AgentAssignment{AgentID: "2"}, nil
+	})
+
+	body := fmt.Sprintf(`{"room_id":"%s","name":"Customer","source":"wa","candidate_agent":{"id":1,"name":"Agent"}}`, roomID)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/allocate", strings.NewReader(body)))
+
+	assert.Equal(t, rec.Code, http.StatusOK)
+	assert.Equal(t, assigned.RoomID, roomID)
+	assert.Equal(t, assigned.AgentID, "2")
+	assert.Equal(t, assigned.MaxAgent, 5)
+}
+
+func TestNewMarkAsResolvedHandler(t *testing.T) {
+	var received *MarkAsResolvedEvent
+
+	h := NewMarkAsResolvedHandler(func(ctx context.Context, e *MarkAsResolvedEvent) error {
+		received = e
+		return nil
+	}, WithSecret("my-secret"))
+
+	body := fmt.Sprintf(`{"customer":{"user_id":"guest@mail.com"},"resolved_by":{"id":1,"type":"agent"},"service":{"room_id":"%s","is_resolved":true,"notes":"done"}}`, roomID)
+
+	// Missing secret is rejected
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/resolved", strings.NewReader(body)))
+	assert.Equal(t, rec.Code, http.StatusUnauthorized)
+	assert.Nil(t, received)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/resolved?secret=my-secret", strings.NewReader(body)))
+	assert.Equal(t, rec.Code, http.StatusOK)
+	assert.Equal(t, received.Customer.UserID, "guest@mail.com")
+	assert.Equal(t, received.Service.RoomID, roomID)
+	assert.Equal(t, received.Service.Notes, "done")
+}
+
+func TestNewBotHandler(t *testing.T) {
+	var received *BotMessage
+
+	h := NewBotHandler(func(ctx context.Context, msg *BotMessage) error {
+		received = msg
+		return nil
+	})
+
+	body := fmt.Sprintf(`{"type":"post_comment_mobile","payload":{"from":{"email":"guest@mail.com"},"room":{"id":"%s"},"message":{"text":"hello","type":"text"}}}`, roomID)
+	req := httptest.NewRequest(http.MethodPost, "/bot", strings.NewReader(body))
+	req.Header.Set(SecretHeader, "ignored-without-secret-option")
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(t, rec.Code, http.StatusOK)
+	assert.Equal(t, received.Payload.Type, "post_comment_mobile")
+	assert.Equal(t, received.Payload.Room.RoomID, roomID)
+	assert.Equal(t, received.Payload.Message.Message, "hello")
+}
+
+func TestHandlerErrorsAreLogged(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errors":"upstream details"}`)
+	}))
+
+	defer srv.Close()
+
+	var logs bytes.Buffer
+	logger := WithLogger(zerolog.New(&logs))
+	client := multichannel.NewMultichannel("app-id", "secret-key", multichannel.WithAPIBase(srv.URL))
+
+	allocationBody := fmt.Sprintf(`{"room_id":"%s","candidate_agent":{"id":1}}`, roomID)
+	tests := []struct {
+		name    string
+		handler http.Handler
+		body    string
+		code    int
+		logged  string
+	}{
+		{
+			name: "allocation callback",
+			handler: NewAgentAllocationHandler(client, func(ctx context.Context, req *AgentAllocationRequest) (*AgentAssignment, error) {
+				return nil, errors.New("callback failed")
+			}, logger),
+			body:   allocationBody,
+			code:   http.StatusInternalServerError,
+			logged: "callback failed",
+		},
+		{
+			name: "assign agent",
+			handler: NewAgentAllocationHandler(client, func(ctx context.Context, req *AgentAllocationRequest) (*AgentAssignment, error) {
+				return &AgentAssignment{AgentID: "2"}, nil
+			}, logger),
+			body:   allocationBody,
+			code:   http.StatusBadGateway,
+			logged: "upstream details",
+		},
+		{
+			name: "mark as resolved callback",
+			handler: NewMarkAsResolvedHandler(func(ctx context.Context, e *MarkAsResolvedEvent) error {
+				return errors.New("callback failed")
+			}, logger),
+			body:   `{"service":{"room_id":"123123"}}`,
+			code:   http.StatusInternalServerError,
+			logged: "callback failed",
+		},
+		{
+			name: "bot callback",
+			handler: NewBotHandler(func(ctx context.Context, msg *BotMessage) error {
+				return errors.New("callback failed")
+			}, logger),
+			body:   `{"type":"post_comment_mobile","payload":{"room":{"id":"123123"}}}`,
+			code:   http.StatusInternalServerError,
+			logged: "callback failed",
+		},
+	}
+
+	for _, tt := range tests {
+		logs.Reset()
+
+		rec := httptest.NewRecorder()
+		tt.handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(tt.body)))
+
+		assert.Equal(t, rec.Code, tt.code, tt.name)
+		assert.Equal(t, strings.TrimSpace(rec.Body.String()), http.StatusText(tt.code), tt.name)
+		assert.Contains(t, logs.String(), tt.logged, tt.name)
+		assert.Contains(t, logs.String(), roomID, tt.name)
+	}
+}
@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	sdkwebhook "github.com/Qiscus-Integration/qiscus-go/sdk/webhook"
+)
+
+// CandidateAgent is Represent the agent proposed by Multichannel in agent allocation webhook
+type CandidateAgent struct {
+	ID                   int    `json:"id"`
+	Name                 string `json:"name"`
+	Email                string `json:"email"`
+	AvatarURL            string `json:"avatar_url"`
+	IsAvailable          bool   `json:"is_available"`
+	CurrentCustomerCount int    `json:"current_customer_count"`
+	TypeAsString         string `json:"type_as_string"`
+}
+
+// AgentAllocationRequest is Represent agent allocation webhook payload
+type AgentAllocationRequest struct {
+	AppID          int             `json:"app_id"`
+	RoomID         string          `json:"room_id"`
+	Name           string          `json:"name"`
+	Email          string          `json:"email"`
+	AvatarURL      string          `json:"avatar_url"`
+	Source         string          `json:"source"`
+	Extras         string          `json:"extras"`
+	IsNewSession   bool            `json:"is_new_session"`
+	IsResolved     bool            `json:"is_resolved"`
+	CandidateAgent *CandidateAgent `json:"candidate_agent"`
+}
+
+// AgentAssignment is the agent to assign to the room, following AssignAgent semantics
+type AgentAssignment struct {
+	AgentID            string
+	ReplaceLatestAgent bool
+	MaxAgent           int // default max agent is 5
+}
+
+// MarkAsResolvedEvent is Represent mark as resolved webhook payload
+type MarkAsResolvedEvent struct {
+	Customer struct {
+		AdditionalInfo []struct {
+			Key   string `json:"key"`
+			Value string `json:"value"`
+		} `json:"additional_info"`
+		Avatar string `json:"avatar"`
+		Name   string `json:"name"`
+		UserID string `json:"user_id"`
+	} `json:"customer"`
+	ResolvedBy struct {
+		ID          int    `json:"id"`
+		Email       string `json:"email"`
+		Name        string `json:"name"`
+		IsAvailable bool   `json:"is_available"`
+		Type        string `json:"type"`
+	} `json:"resolved_by"`
+	Service struct {
+		ID             int    `json:"id"`
+		RoomID         string `json:"room_id"`
+		Notes          string `json:"notes"`
+		IsResolved     bool   `json:"is_resolved"`
+		FirstCommentID string `json:"first_comment_id"`
+		LastCommentID  string `json:"last_comment_id"`
+		Source         string `json:"source"`
+	} `json:"service"`
+}
+
+// BotMessage is Represent bot webhook payload, it has the same shape as SDK post comment webhook
+type BotMessage struct {
+	Type    string                      `json:"type"`
+	Payload sdkwebhook.PostCommentEvent `json:"payload"`
+}
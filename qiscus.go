@@ -17,4 +17,7 @@ var (
 
 	// DefaultHttpOutboundLog default HTTP outbound log
 	DefaultHttpOutboundLog = false
+
+	// DefaultUserAgent default User-Agent header sent to Qiscus API
+	DefaultUserAgent = "Qiscus-Go/" + LibraryVersion
 )
@@ -0,0 +1,134 @@
+package qiscus
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DefaultRetryPolicy default retry policy for every request, nil means the request is sent only once
+var DefaultRetryPolicy *RetryPolicy
+
+// RetryPolicy configures automatic retry of failed requests with exponential backoff.
+// Only idempotent methods (GET, HEAD, OPTIONS, PUT, DELETE) are retried unless
+// RetryNonIdempotent is set or the call is made with a context returned by AllowRetry.
+type RetryPolicy struct {
+	MaxAttempts          int           // total attempts including the first one, default 3
+	InitialBackoff       time.Duration // default 500ms
+	MaxBackoff           time.Duration // default 10s
+	Multiplier           float64       // default 2
+	Jitter               float64       // randomization factor between 0 and 1, default 0.2
+	RetryableStatusCodes []int         // default 429, 502, 503 and 504
+	RetryNonIdempotent   bool          // retry POST and PATCH requests as well, default false
+}
+
+// NewRetryPolicy returns a retry policy with default values
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+type allowRetryKey struct{}
+
+// AllowRetry returns a copy of ctx that marks the call as safe to retry,
+// use it for non-idempotent calls such as PostComment when duplicates are acceptable.
+func AllowRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, allowRetryKey{}, true)
+}
+
+func retryAllowed(ctx context.Context) bool {
+	allowed, _ := ctx.Value(allowRetryKey{}).(bool)
+	return allowed
+}
+
+// canRetry reports whether a request with the given method may be sent again
+func (p *RetryPolicy) canRetry(ctx context.Context, method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return p.RetryNonIdempotent || retryAllowed(ctx)
+}
+
+// shouldRetry reports whether the result of an attempt is worth retrying
+func (p *RetryPolicy) shouldRetry(ctx context.Context, res *http.Response, err error) bool {
+	if err != nil {
+		// Only network errors are retried, a request that cannot be created fails the same way every time.
+		// Do not retry when the caller gave up.
+		return ctx.Err() == nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) && isTransportError(err)
+	}
+
+	for _, code := range p.RetryableStatusCodes {
+		if res.StatusCode == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the wait duration before the next attempt, attempt starts at 1.
+// A Retry-After header is honoured, capped by MaxBackoff like the computed backoff.
+func (p *RetryPolicy) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if wait, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+				wait = p.MaxBackoff
+			}
+			return wait
+		}
+	}
+
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	wait := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && wait > float64(p.MaxBackoff) {
+		wait = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		wait -= wait * p.Jitter * rand.Float64()
+	}
+
+	return time.Duration(wait)
+}
+
+// parseRetryAfter parses Retry-After header value, either in seconds or HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		wait := time.Until(t)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,59 @@
+package qiscus
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoffRetryAfter(t *testing.T) {
+	p := &RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 10 * time.Second, Multiplier: 2}
+
+	retryAfter := func(value string) *http.Response {
+		return &http.Response{Header: http.Header{"Retry-After": []string{value}}}
+	}
+
+	assert.Equal(t, p.backoff(1, retryAfter("3")), 3*time.Second)
+	assert.Equal(t, p.backoff(1, retryAfter("3600")), 10*time.Second)
+	assert.Equal(t, p.backoff(1, retryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))), 10*time.Second)
+
+	// Without MaxBackoff the Retry-After value is used as is
+	p.MaxBackoff = 0
+	assert.Equal(t, p.backoff(1, retryAfter("3600")), time.Hour)
+}
+
+func TestBackoffExponential(t *testing.T) {
+	p := &RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second, Multiplier: 2}
+
+	assert.Equal(t, p.backoff(1, nil), time.Second)
+	assert.Equal(t, p.backoff(3, nil), 4*time.Second)
+	assert.Equal(t, p.backoff(4, nil), 5*time.Second)
+}
+
+// countingTransport counts the requests sent through it
+type countingTransport struct {
+	calls int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestRetryMalformedURL(t *testing.T) {
+	transport := &countingTransport{}
+	policy := &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, Multiplier: 2}
+
+	r := NewHttpRequest(http.MethodGet, "ftp://example.com", nil, nil).(*HttpRequestImpl)
+	r.SetHttpClient(&http.Client{Transport: transport})
+	r.SetRetryPolicy(policy)
+
+	assert.NotNil(t, r.DoRequest())
+	assert.Equal(t, transport.calls, 1)
+
+	// The URL cannot be parsed, the request is never sent nor retried
+	assert.False(t, policy.shouldRetry(context.Background(), nil, rawErrorOf(NewHttpRequest(http.MethodGet, "http://[::1", nil, nil).DoRequest())))
+}
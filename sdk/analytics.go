@@ -0,0 +1,216 @@
+package sdk
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+)
+
+// ReportOption configures GetResponseRateReport
+type ReportOption func(*reportConfig)
+
+type reportConfig struct {
+	batchSize   int
+	concurrency int
+}
+
+// WithReportBatchSize sets the number of users requested per GetUserResponseRate call, default 50
+func WithReportBatchSize(n int) ReportOption {
+	return func(c *reportConfig) {
+		c.batchSize = n
+	}
+}
+
+// WithReportConcurrency sets the number of GetUserResponseRate calls sent concurrently, default 4
+func WithReportConcurrency(n int) ReportOption {
+	return func(c *reportConfig) {
+		c.concurrency = n
+	}
+}
+
+// UserReplyTime is the reply time of a user in a ResponseRateReport
+type UserReplyTime struct {
+	UserID   string
+	Average  time.Duration
+	Shortest time.Duration
+	Longest  time.Duration
+}
+
+// HasReplies reports whether the user replied in the time window
+func (u UserReplyTime) HasReplies() bool {
+	return u.Average > 0 || u.Longest > 0
+}
+
+// ResponseRateReport is the reply time of many users in a time window
+type ResponseRateReport struct {
+	StartTime time.Time
+	EndTime   time.Time
+
+	// Users is every requested user, sorted by average reply time, users without reply last
+	Users []UserReplyTime
+
+	// Overall statistics of the users with replies, zero when no user replied
+	Average  time.Duration // mean of the user averages
+	Shortest time.Duration // shortest reply of all users
+	Longest  time.Duration // longest reply of all users
+
+	averages []time.Duration // sorted user averages of the users with replies
+}
+
+// Percentile returns the p-th percentile of the user averages, p between 0 and 100,
+// interpolated between the closest ranks. It returns 0 when no user replied.
+func (r *ResponseRateReport) Percentile(p float64) time.Duration {
+	n := len(r.averages)
+	if n == 0 {
+		return 0
+	}
+
+	p = math.Max(0, math.Min(100, p))
+	rank := p / 100 * float64(n-1)
+	lo, hi := int(math.Floor(rank)), int(math.Ceil(rank))
+	frac := rank - float64(lo)
+
+	return r.averages[lo] + time.Duration(frac*float64(r.averages[hi]-r.averages[lo]))
+}
+
+// GetResponseRateReport gets the reply time of every user of req, in batches sent concurrently,
+// and returns a report comparing them. The first failing batch cancels the others and its error is returned.
+func GetResponseRateReport(ctx context.Context, c SDK, req *GetUserResponseRateReq, opts ...ReportOption) (*ResponseRateReport, *qiscus.Error) {
+	cfg := reportConfig{batchSize: 50, concurrency: 4}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if cfg.batchSize < 1 {
+		cfg.batchSize = 1
+	}
+
+	if cfg.concurrency < 1 {
+		cfg.concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var batches [][]string
+	for start := 0; start < len(req.UserIDs); start += cfg.batchSize {
+		end := start + cfg.batchSize
+		if end > len(req.UserIDs) {
+			end = len(req.UserIDs)
+		}
+		batches = append(batches, req.UserIDs[start:end])
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr *qiscus.Error
+		results  = make(map[string]ReplyDuration)
+		sem      = make(chan struct{}, cfg.concurrency)
+	)
+
+	for _, batch := range batches {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+
+		go func(userIDs []string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			batchReq := &GetUserResponseRateReq{UserIDs: userIDs, StartTime: req.StartTime, EndTime: req.EndTime}
+			resp, err := c.GetUserResponseRateContext(ctx, batchReq)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+
+			for _, d := range resp.Results.Data {
+				results[d.UserID] = d.Duration
+			}
+		}(batch)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, &qiscus.Error{
+			Message:  "get response rate report failed. " + err.Error(),
+			RawError: err,
+		}
+	}
+
+	return newResponseRateReport(req, results), nil
+}
+
+// newResponseRateReport computes the report of the requested users from their reply durations
+func newResponseRateReport(req *GetUserResponseRateReq, durations map[string]ReplyDuration) *ResponseRateReport {
+	report := &ResponseRateReport{StartTime: req.StartTime, EndTime: req.EndTime}
+
+	var total time.Duration
+	seen := make(map[string]bool)
+	for _, userID := range req.UserIDs {
+		if seen[userID] {
+			continue
+		}
+		seen[userID] = true
+
+		d := durations[userID]
+		u := UserReplyTime{
+			UserID:   userID,
+			Average:  time.Duration(d.Average) * time.Second,
+			Shortest: time.Duration(d.Shortest) * time.Second,
+			Longest:  time.Duration(d.Longest) * time.Second,
+		}
+		report.Users = append(report.Users, u)
+
+		if !u.HasReplies() {
+			continue
+		}
+
+		if len(report.averages) == 0 || u.Shortest < report.Shortest {
+			report.Shortest = u.Shortest
+		}
+		if u.Longest > report.Longest {
+			report.Longest = u.Longest
+		}
+		total += u.Average
+		report.averages = append(report.averages, u.Average)
+	}
+
+	if n := len(report.averages); n > 0 {
+		report.Average = total / time.Duration(n)
+	}
+
+	sort.Slice(report.averages, func(i, j int) bool { return report.averages[i] < report.averages[j] })
+	sort.SliceStable(report.Users, func(i, j int) bool {
+		a, b := report.Users[i], report.Users[j]
+		if a.HasReplies() != b.HasReplies() {
+			return a.HasReplies()
+		}
+		return a.Average < b.Average
+	})
+
+	return report
+}
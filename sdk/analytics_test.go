@@ -0,0 +1,93 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetResponseRateReport(t *testing.T) {
+	// Average reply time of user-N is N seconds, user-0 has no reply
+	var requests int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.URL.Path, "/api/v2.1/rest/get_user_response_rate")
+		atomic.AddInt32(&requests, 1)
+
+		userIDs := req.URL.Query()["user_ids[]"]
+		assert.LessOrEqual(t, len(userIDs), 2)
+
+		var data []string
+		for _, userID := range userIDs {
+			var n int
+			fmt.Sscanf(userID, "user-%d", &n)
+			data = append(data, fmt.Sprintf(`{"user_id":"%s","duration":{"average":%d,"longest":%d,"shortest":%d}}`, userID, n, n*2, n/2))
+		}
+
+		fmt.Fprintf(w, `{"results":{"data":[%s]}}`, strings.Join(data, ","))
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	req := &GetUserResponseRateReq{
+		UserIDs:   []string{"user-40", "user-0", "user-10", "user-30", "user-20"},
+		StartTime: time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2021, 9, 30, 23, 59, 59, 0, time.UTC),
+	}
+
+	report, err := GetResponseRateReport(context.Background(), c, req, WithReportBatchSize(2), WithReportConcurrency(2))
+	assert.Nil(t, err)
+	assert.Equal(t, atomic.LoadInt32(&requests), int32(3))
+
+	var userIDs []string
+	for _, u := range report.Users {
+		userIDs = append(userIDs, u.UserID)
+	}
+	assert.Equal(t, userIDs, []string{"user-10", "user-20", "user-30", "user-40", "user-0"})
+	assert.False(t, report.Users[4].HasReplies())
+
+	assert.Equal(t, report.Average, 25*time.Second)
+	assert.Equal(t, report.Shortest, 5*time.Second)
+	assert.Equal(t, report.Longest, 80*time.Second)
+	assert.Equal(t, report.Percentile(0), 10*time.Second)
+	assert.Equal(t, report.Percentile(50), 25*time.Second)
+	assert.Equal(t, report.Percentile(90), 37*time.Second)
+	assert.Equal(t, report.Percentile(100), 40*time.Second)
+}
+
+func TestGetResponseRateReportError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("user_ids[]") == "unknown@mail.com" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error":{"message":"user not found"},"status":404}`)
+			return
+		}
+		fmt.Fprint(w, `{"results":{"data":[]}}`)
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	req := &GetUserResponseRateReq{UserIDs: []string{"alice@mail.com", "unknown@mail.com", "bob@mail.com"}}
+	report, err := GetResponseRateReport(context.Background(), c, req, WithReportBatchSize(1))
+	assert.Nil(t, report)
+	assert.True(t, errors.Is(err, qiscus.ErrNotFound))
+
+	// A report of users without reply has no statistics
+	report, err = GetResponseRateReport(context.Background(), c, &GetUserResponseRateReq{UserIDs: []string{"alice@mail.com"}})
+	assert.Nil(t, err)
+	assert.Equal(t, report.Average, time.Duration(0))
+	assert.Equal(t, report.Percentile(50), time.Duration(0))
+}
@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -12,125 +13,154 @@ import (
 
 // LoginOrRegister Login or register
 func (s *SDKImpl) LoginOrRegister(req *LoginOrRegisterReq) (*LoginOrRegisterResponse, *qiscus.Error) {
+	return s.LoginOrRegisterContext(context.Background(), req)
+}
+
+// LoginOrRegisterContext Login or register with context
+func (s *SDKImpl) LoginOrRegisterContext(ctx context.Context, req *LoginOrRegisterReq) (*LoginOrRegisterResponse, *qiscus.Error) {
 	resp := &LoginOrRegisterResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/login_or_register", s.APIBase())
 
 	jsonReq, _ := json.Marshal(req)
 
-	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
-	err := r.DoRequest()
+	r := s.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // GetUserProfile Get user profile by user ID
 func (s *SDKImpl) GetUserProfile(userID string) (*GetUserProfileResponse, *qiscus.Error) {
+	return s.GetUserProfileContext(context.Background(), userID)
+}
+
+// GetUserProfileContext Get user profile by user ID with context
+func (s *SDKImpl) GetUserProfileContext(ctx context.Context, userID string) (*GetUserProfileResponse, *qiscus.Error) {
 	resp := &GetUserProfileResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/user_profile", s.APIBase())
 
-	r := qiscus.NewHttpRequest(http.MethodGet, url, nil, resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
+	r := s.newRequest(http.MethodGet, url, nil, resp)
 	r.AddParameter("user_id", userID)
-	err := r.DoRequest()
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // GetUserToken Get user profile by user ID
 func (s *SDKImpl) GetUserToken(userID string) (*GetUserTokenResponse, *qiscus.Error) {
+	return s.GetUserTokenContext(context.Background(), userID)
+}
+
+// GetUserTokenContext Get user profile by user ID with context
+func (s *SDKImpl) GetUserTokenContext(ctx context.Context, userID string) (*GetUserTokenResponse, *qiscus.Error) {
 	resp := &GetUserTokenResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/get_user_token", s.APIBase())
 
-	r := qiscus.NewHttpRequest(http.MethodGet, url, nil, resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
+	r := s.newRequest(http.MethodGet, url, nil, resp)
 	r.AddParameter("user_id", userID)
-	err := r.DoRequest()
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // ResetUserToken Reset user token by user ID
 func (s *SDKImpl) ResetUserToken(userID string) (*GetUserTokenResponse, *qiscus.Error) {
+	return s.ResetUserTokenContext(context.Background(), userID)
+}
+
+// ResetUserTokenContext Reset user token by user ID with context
+func (s *SDKImpl) ResetUserTokenContext(ctx context.Context, userID string) (*GetUserTokenResponse, *qiscus.Error) {
 	resp := &GetUserTokenResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/reset_user_token", s.APIBase())
 
 	req := &ResetUserTokenReq{UserID: userID}
 	jsonReq, _ := json.Marshal(req)
 
-	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
-	err := r.DoRequest()
+	r := s.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // CreateRoom Create new room
 func (s *SDKImpl) CreateRoom(req *CreateRoomReq) (*CreateRoomResponse, *qiscus.Error) {
+	return s.CreateRoomContext(context.Background(), req)
+}
+
+// CreateRoomContext Create new room with context
+func (s *SDKImpl) CreateRoomContext(ctx context.Context, req *CreateRoomReq) (*CreateRoomResponse, *qiscus.Error) {
 	resp := &CreateRoomResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/create_room", s.APIBase())
 	jsonReq, _ := json.Marshal(req)
 
-	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
-	err := r.DoRequest()
+	r := s.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // GetOrCreateRoomWithTarget Get or create new room with target
 func (s *SDKImpl) GetOrCreateRoomWithTarget(req *GetOrCreateRoomWithTargetReq) (*CreateRoomResponse, *qiscus.Error) {
+	return s.GetOrCreateRoomWithTargetContext(context.Background(), req)
+}
+
+// GetOrCreateRoomWithTargetContext Get or create new room with target with context
+func (s *SDKImpl) GetOrCreateRoomWithTargetContext(ctx context.Context, req *GetOrCreateRoomWithTargetReq) (*CreateRoomResponse, *qiscus.Error) {
 	resp := &CreateRoomResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/get_or_create_room_with_target", s.APIBase())
 	jsonReq, _ := json.Marshal(req)
 
-	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
-	err := r.DoRequest()
+	r := s.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // GetRoomsInfo Get rooms info by room IDs
 func (s *SDKImpl) GetRoomsInfo(roomIDs []string) (*GetRoomsInfoResponse, *qiscus.Error) {
+	return s.GetRoomsInfoContext(context.Background(), roomIDs)
+}
+
+// GetRoomsInfoContext Get rooms info by room IDs with context
+func (s *SDKImpl) GetRoomsInfoContext(ctx context.Context, roomIDs []string) (*GetRoomsInfoResponse, *qiscus.Error) {
 	resp := &GetRoomsInfoResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/get_rooms_info", s.APIBase())
 
-	r := qiscus.NewHttpRequest(http.MethodGet, url, nil, resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
+	r := s.newRequest(http.MethodGet, url, nil, resp)
 
 	for _, roomID := range roomIDs {
 		r.AddParameter("room_ids[]", roomID)
 	}
 
-	err := r.DoRequest()
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // UpdateRoom Update room
 func (s *SDKImpl) UpdateRoom(req *UpdateRoomReq) (*UpdateRoomResponse, *qiscus.Error) {
+	return s.UpdateRoomContext(context.Background(), req)
+}
+
+// UpdateRoomContext Update room with context
+func (s *SDKImpl) UpdateRoomContext(ctx context.Context, req *UpdateRoomReq) (*UpdateRoomResponse, *qiscus.Error) {
 	resp := &UpdateRoomResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/update_room", s.APIBase())
 	jsonReq, _ := json.Marshal(req)
 
-	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
-	err := r.DoRequest()
+	r := s.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
-// GetRoomParticipants is Represent Get room participant
+// GetRoomParticipants Get room participants
 func (s *SDKImpl) GetRoomParticipants(req *GetRoomParticipantsReq) (*GetRoomParticipantsResponse, *qiscus.Error) {
+	return s.GetRoomParticipantsContext(context.Background(), req)
+}
+
+// GetRoomParticipantsContext Get room participants with context
+func (s *SDKImpl) GetRoomParticipantsContext(ctx context.Context, req *GetRoomParticipantsReq) (*GetRoomParticipantsResponse, *qiscus.Error) {
 	resp := &GetRoomParticipantsResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/get_room_participants", s.APIBase())
 
@@ -144,47 +174,56 @@ func (s *SDKImpl) GetRoomParticipants(req *GetRoomParticipantsReq) (*GetRoomPart
 		req.Limit = 20
 	}
 
-	r := qiscus.NewHttpRequest(http.MethodGet, url, nil, resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
+	r := s.newRequest(http.MethodGet, url, nil, resp)
 	r.AddParameter("room_id", req.RoomID)
 	r.AddParameter("page", strconv.Itoa(req.Page))
 	r.AddParameter("limit", strconv.Itoa(req.Limit))
-	err := r.DoRequest()
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // AddRoomParticipants Add room participants
 func (s *SDKImpl) AddRoomParticipants(req *AddRoomParticipantsReq) (*AddRoomParticipantsResponse, *qiscus.Error) {
+	return s.AddRoomParticipantsContext(context.Background(), req)
+}
+
+// AddRoomParticipantsContext Add room participants with context
+func (s *SDKImpl) AddRoomParticipantsContext(ctx context.Context, req *AddRoomParticipantsReq) (*AddRoomParticipantsResponse, *qiscus.Error) {
 	resp := &AddRoomParticipantsResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/add_room_participants", s.APIBase())
 	jsonReq, _ := json.Marshal(req)
 
-	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
-	err := r.DoRequest()
+	r := s.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // RemoveRoomParticipants Remove room participants
 func (s *SDKImpl) RemoveRoomParticipants(req *RemoveRoomParticipantsReq) (*RemoveRoomParticipantsResponse, *qiscus.Error) {
+	return s.RemoveRoomParticipantsContext(context.Background(), req)
+}
+
+// RemoveRoomParticipantsContext Remove room participants with context
+func (s *SDKImpl) RemoveRoomParticipantsContext(ctx context.Context, req *RemoveRoomParticipantsReq) (*RemoveRoomParticipantsResponse, *qiscus.Error) {
 	resp := &RemoveRoomParticipantsResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/remove_room_participants", s.APIBase())
 	jsonReq, _ := json.Marshal(req)
 
-	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
-	err := r.DoRequest()
+	r := s.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // GetUserRooms Get user rooms
 func (s *SDKImpl) GetUserRooms(req *GetUserRoomsReq) (*GetUserRoomsResponse, *qiscus.Error) {
+	return s.GetUserRoomsContext(context.Background(), req)
+}
+
+// GetUserRoomsContext Get user rooms with context
+func (s *SDKImpl) GetUserRoomsContext(ctx context.Context, req *GetUserRoomsReq) (*GetUserRoomsResponse, *qiscus.Error) {
 	resp := &GetUserRoomsResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/get_user_rooms", s.APIBase())
 
@@ -198,13 +237,11 @@ func (s *SDKImpl) GetUserRooms(req *GetUserRoomsReq) (*GetUserRoomsResponse, *qi
 		req.Limit = 20
 	}
 
-	r := qiscus.NewHttpRequest(http.MethodGet, url, nil, resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
+	r := s.newRequest(http.MethodGet, url, nil, resp)
 	r.AddParameter("user_id", req.UserID)
 	r.AddParameter("page", strconv.Itoa(req.Page))
 	r.AddParameter("limit", strconv.Itoa(req.Limit))
-	err := r.DoRequest()
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 
@@ -212,20 +249,28 @@ func (s *SDKImpl) GetUserRooms(req *GetUserRoomsReq) (*GetUserRoomsResponse, *qi
 
 // PostComment Post comment
 func (s *SDKImpl) PostComment(req *PostCommentReq) (*PostCommentResponse, *qiscus.Error) {
+	return s.PostCommentContext(context.Background(), req)
+}
+
+// PostCommentContext Post comment with context
+func (s *SDKImpl) PostCommentContext(ctx context.Context, req *PostCommentReq) (*PostCommentResponse, *qiscus.Error) {
 	resp := &PostCommentResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/post_comment", s.APIBase())
 	jsonReq, _ := json.Marshal(req)
 
-	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
-	err := r.DoRequest()
+	r := s.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // LoadComments load comments
 func (s *SDKImpl) LoadComments(req *LoadCommentsReq) (*LoadCommentsResponse, *qiscus.Error) {
+	return s.LoadCommentsContext(context.Background(), req)
+}
+
+// LoadCommentsContext load comments with context
+func (s *SDKImpl) LoadCommentsContext(ctx context.Context, req *LoadCommentsReq) (*LoadCommentsResponse, *qiscus.Error) {
 	resp := &LoadCommentsResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/load_comments", s.APIBase())
 
@@ -239,19 +284,22 @@ func (s *SDKImpl) LoadComments(req *LoadCommentsReq) (*LoadCommentsResponse, *qi
 		req.Limit = 20
 	}
 
-	r := qiscus.NewHttpRequest(http.MethodGet, url, nil, resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
+	r := s.newRequest(http.MethodGet, url, nil, resp)
 	r.AddParameter("room_id", req.RoomID)
 	r.AddParameter("page", strconv.Itoa(req.Page))
 	r.AddParameter("limit", strconv.Itoa(req.Limit))
-	err := r.DoRequest()
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // PostSystemEventMessage post system event message
 func (s *SDKImpl) PostSystemEventMessage(req *PostSystemEventMessageReq) (*PostSystemEventMessageResponse, *qiscus.Error) {
+	return s.PostSystemEventMessageContext(context.Background(), req)
+}
+
+// PostSystemEventMessageContext post system event message with context
+func (s *SDKImpl) PostSystemEventMessageContext(ctx context.Context, req *PostSystemEventMessageReq) (*PostSystemEventMessageResponse, *qiscus.Error) {
 	resp := &PostSystemEventMessageResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/post_system_event_message", s.APIBase())
 
@@ -271,35 +319,41 @@ func (s *SDKImpl) PostSystemEventMessage(req *PostSystemEventMessageReq) (*PostS
 
 	jsonReq, _ := json.Marshal(newReq)
 
-	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
-	err := r.DoRequest()
+	r := s.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // GetUnreadCount get unread count in room
 func (s *SDKImpl) GetUnreadCount(req *GetUnreadCountReq) (*GetUnreadCountResponse, *qiscus.Error) {
+	return s.GetUnreadCountContext(context.Background(), req)
+}
+
+// GetUnreadCountContext get unread count in room with context
+func (s *SDKImpl) GetUnreadCountContext(ctx context.Context, req *GetUnreadCountReq) (*GetUnreadCountResponse, *qiscus.Error) {
 	resp := &GetUnreadCountResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/get_unread_count", s.APIBase())
 
-	r := qiscus.NewHttpRequest(http.MethodGet, url, nil, resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
+	r := s.newRequest(http.MethodGet, url, nil, resp)
 	r.AddParameter("user_id", req.UserID)
 
 	for _, roomID := range req.RoomIDs {
 		r.AddParameter("room_ids[]", roomID)
 	}
 
-	err := r.DoRequest()
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // GetUsers get users
 func (s *SDKImpl) GetUsers(req *GetUsersReq) (*GetUsersResponse, *qiscus.Error) {
+	return s.GetUsersContext(context.Background(), req)
+}
+
+// GetUsersContext get users with context
+func (s *SDKImpl) GetUsersContext(ctx context.Context, req *GetUsersReq) (*GetUsersResponse, *qiscus.Error) {
 	resp := &GetUsersResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/get_user_list", s.APIBase())
 
@@ -318,66 +372,99 @@ func (s *SDKImpl) GetUsers(req *GetUsersReq) (*GetUsersResponse, *qiscus.Error)
 		req.OrderQuery = "created_at desc nulls last"
 	}
 
-	r := qiscus.NewHttpRequest(http.MethodGet, url, nil, resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
+	r := s.newRequest(http.MethodGet, url, nil, resp)
 	r.AddParameter("page", strconv.Itoa(req.Page))
 	r.AddParameter("limit", strconv.Itoa(req.Limit))
 	r.AddParameter("show_all", strconv.FormatBool(req.ShowAll))
 	r.AddParameter("order_query", req.OrderQuery)
-	err := r.DoRequest()
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // LoadCommentsWithRange load comments with range
 func (s *SDKImpl) LoadCommentsWithRange(req *LoadCommentsWithRangeReq) (*LoadCommentsWithRangeResponse, *qiscus.Error) {
+	return s.LoadCommentsWithRangeContext(context.Background(), req)
+}
+
+// LoadCommentsWithRangeContext load comments with range with context
+func (s *SDKImpl) LoadCommentsWithRangeContext(ctx context.Context, req *LoadCommentsWithRangeReq) (*LoadCommentsWithRangeResponse, *qiscus.Error) {
 	resp := &LoadCommentsWithRangeResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/load_comments_with_range", s.APIBase())
 
-	r := qiscus.NewHttpRequest(http.MethodGet, url, nil, resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
+	r := s.newRequest(http.MethodGet, url, nil, resp)
 	r.AddParameter("room_id", req.RoomID)
 	r.AddParameter("first_comment_id", req.FirstCommentID)
 	r.AddParameter("last_comment_id", req.LastCommentID)
-	err := r.DoRequest()
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // GetOrCreateChannel get or create channel
 func (s *SDKImpl) GetOrCreateChannel(req *GetOrCreateChannelReq) (*GetOrCreateChannelResponse, *qiscus.Error) {
+	return s.GetOrCreateChannelContext(context.Background(), req)
+}
+
+// GetOrCreateChannelContext get or create channel with context
+func (s *SDKImpl) GetOrCreateChannelContext(ctx context.Context, req *GetOrCreateChannelReq) (*GetOrCreateChannelResponse, *qiscus.Error) {
 	resp := &GetOrCreateChannelResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/get_or_create_channel", s.APIBase())
 	jsonReq, _ := json.Marshal(req)
 
-	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
-	err := r.DoRequest()
+	r := s.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // GetAverageReplyTimeUser get average reply time user
 func (s *SDKImpl) GetAverageReplyTimeUser(req *GetAverageReplyTimeUserReq) (*GetAverageReplyTimeUserResponse, *qiscus.Error) {
+	return s.GetAverageReplyTimeUserContext(context.Background(), req)
+}
+
+// GetAverageReplyTimeUserContext get average reply time user with context
+func (s *SDKImpl) GetAverageReplyTimeUserContext(ctx context.Context, req *GetAverageReplyTimeUserReq) (*GetAverageReplyTimeUserResponse, *qiscus.Error) {
 	resp := &GetAverageReplyTimeUserResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/get_average_reply_time_user", s.APIBase())
 
-	r := qiscus.NewHttpRequest(http.MethodGet, url, nil, resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
+	r := s.newRequest(http.MethodGet, url, nil, resp)
 	r.AddParameter("user_id", req.UserID)
-	r.AddParameter("start_time", req.StartTime)
-	r.AddParameter("end_time", req.EndTime)
-	err := r.DoRequest()
+	r.AddParameter("start_time", qiscus.FormatDateTime(req.StartTime))
+	r.AddParameter("end_time", qiscus.FormatDateTime(req.EndTime))
+	err := qiscus.DoRequestContext(ctx, r)
+
+	return resp, err
+}
+
+// GetUserResponseRate get reply time of multiple users
+func (s *SDKImpl) GetUserResponseRate(req *GetUserResponseRateReq) (*GetUserResponseRateResponse, *qiscus.Error) {
+	return s.GetUserResponseRateContext(context.Background(), req)
+}
+
+// GetUserResponseRateContext get reply time of multiple users with context
+func (s *SDKImpl) GetUserResponseRateContext(ctx context.Context, req *GetUserResponseRateReq) (*GetUserResponseRateResponse, *qiscus.Error) {
+	resp := &GetUserResponseRateResponse{}
+	url := fmt.Sprintf("%s/api/v2.1/rest/get_user_response_rate", s.APIBase())
+
+	r := s.newRequest(http.MethodGet, url, nil, resp)
+	for _, userID := range req.UserIDs {
+		r.AddParameter("user_ids[]", userID)
+	}
+	r.AddParameter("start_time", qiscus.FormatDateTime(req.StartTime))
+	r.AddParameter("end_time", qiscus.FormatDateTime(req.EndTime))
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // GetWebhookLogs get webhook logs
 func (s *SDKImpl) GetWebhookLogs(req *GetWebhookLogsReq) (*GetWebhookLogsResponse, *qiscus.Error) {
+	return s.GetWebhookLogsContext(context.Background(), req)
+}
+
+// GetWebhookLogsContext get webhook logs with context
+func (s *SDKImpl) GetWebhookLogsContext(ctx context.Context, req *GetWebhookLogsReq) (*GetWebhookLogsResponse, *qiscus.Error) {
 	resp := &GetWebhookLogsResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/webhook_logs", s.APIBase())
 
@@ -396,41 +483,223 @@ func (s *SDKImpl) GetWebhookLogs(req *GetWebhookLogsReq) (*GetWebhookLogsRespons
 		req.Type = "all"
 	}
 
-	r := qiscus.NewHttpRequest(http.MethodGet, url, nil, resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
+	r := s.newRequest(http.MethodGet, url, nil, resp)
 	r.AddParameter("page", strconv.Itoa(req.Page))
 	r.AddParameter("limit", strconv.Itoa(req.Limit))
 	r.AddParameter("type", req.Type)
-	err := r.DoRequest()
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // DeactivateUser deactivate user
 func (s *SDKImpl) DeactivateUser(req *DeactivateUserReq) (*DeactivateUserResponse, *qiscus.Error) {
+	return s.DeactivateUserContext(context.Background(), req)
+}
+
+// DeactivateUserContext deactivate user with context
+func (s *SDKImpl) DeactivateUserContext(ctx context.Context, req *DeactivateUserReq) (*DeactivateUserResponse, *qiscus.Error) {
 	resp := &DeactivateUserResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/deactivate_users", s.APIBase())
 	jsonReq, _ := json.Marshal(req)
 
-	r := qiscus.NewHttpRequest(http.MethodDelete, url, bytes.NewBuffer(jsonReq), resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
-	err := r.DoRequest()
+	r := s.newRequest(http.MethodDelete, url, bytes.NewBuffer(jsonReq), resp)
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
 
 // ReactivateUser deactivate user
 func (s *SDKImpl) ReactivateUser(req *ReactivateUserReq) (*ReactivateUserResponse, *qiscus.Error) {
+	return s.ReactivateUserContext(context.Background(), req)
+}
+
+// ReactivateUserContext reactivate user with context
+func (s *SDKImpl) ReactivateUserContext(ctx context.Context, req *ReactivateUserReq) (*ReactivateUserResponse, *qiscus.Error) {
 	resp := &ReactivateUserResponse{}
 	url := fmt.Sprintf("%s/api/v2.1/rest/reactivate_users", s.APIBase())
 	jsonReq, _ := json.Marshal(req)
 
-	r := qiscus.NewHttpRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
-	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
-	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
-	err := r.DoRequest()
+	r := s.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
+	err := qiscus.DoRequestContext(ctx, r)
+
+	return resp, err
+}
+
+// DeleteComments delete comments by ID or unique ID, for everyone or for the sender only
+func (s *SDKImpl) DeleteComments(req *DeleteCommentsReq) (*DeleteCommentsResponse, *qiscus.Error) {
+	return s.DeleteCommentsContext(context.Background(), req)
+}
+
+// DeleteCommentsContext delete comments by ID or unique ID, for everyone or for the sender only with context
+func (s *SDKImpl) DeleteCommentsContext(ctx context.Context, req *DeleteCommentsReq) (*DeleteCommentsResponse, *qiscus.Error) {
+	resp := &DeleteCommentsResponse{}
+	url := fmt.Sprintf("%s/api/v2.1/rest/delete_messages", s.APIBase())
+
+	fieldErrors := make(map[string][]string)
+	if req.UserID == "" {
+		fieldErrors["user_id"] = []string{"user_id is required"}
+	}
+	if len(req.CommentIDs) == 0 && len(req.UniqueIDs) == 0 {
+		fieldErrors["comment_ids"] = []string{"comment_ids or unique_ids is required"}
+	}
+	if req.HardDelete && !req.ForEveryone {
+		fieldErrors["is_hard_delete"] = []string{"hard delete requires delete for everyone"}
+	}
+	if len(fieldErrors) > 0 {
+		return resp, &qiscus.Error{
+			Message:     "delete comments failed. invalid request",
+			FieldErrors: fieldErrors,
+		}
+	}
+
+	jsonReq, _ := json.Marshal(req)
+
+	r := s.newRequest(http.MethodDelete, url, bytes.NewBuffer(jsonReq), resp)
+	err := qiscus.DoRequestContext(ctx, r)
+
+	return resp, err
+}
+
+// ClearRoomMessages delete every comment of the rooms
+func (s *SDKImpl) ClearRoomMessages(req *ClearRoomMessagesReq) (*ClearRoomMessagesResponse, *qiscus.Error) {
+	return s.ClearRoomMessagesContext(context.Background(), req)
+}
+
+// ClearRoomMessagesContext delete every comment of the rooms with context
+func (s *SDKImpl) ClearRoomMessagesContext(ctx context.Context, req *ClearRoomMessagesReq) (*ClearRoomMessagesResponse, *qiscus.Error) {
+	resp := &ClearRoomMessagesResponse{}
+	url := fmt.Sprintf("%s/api/v2.1/rest/clear_room_messages", s.APIBase())
+
+	if len(req.RoomIDs) == 0 {
+		return resp, &qiscus.Error{
+			Message:     "clear room messages failed. room_ids is empty",
+			FieldErrors: map[string][]string{"room_ids": {"room_ids is empty"}},
+		}
+	}
+
+	jsonReq, _ := json.Marshal(req)
+
+	r := s.newRequest(http.MethodDelete, url, bytes.NewBuffer(jsonReq), resp)
+	err := qiscus.DoRequestContext(ctx, r)
+
+	return resp, err
+}
+
+// UpdateComment update the message, payload and extras of a comment by ID or unique ID
+func (s *SDKImpl) UpdateComment(req *UpdateCommentReq) (*UpdateCommentResponse, *qiscus.Error) {
+	return s.UpdateCommentContext(context.Background(), req)
+}
+
+// UpdateCommentContext update the message, payload and extras of a comment by ID or unique ID with context
+func (s *SDKImpl) UpdateCommentContext(ctx context.Context, req *UpdateCommentReq) (*UpdateCommentResponse, *qiscus.Error) {
+	resp := &UpdateCommentResponse{}
+	url := fmt.Sprintf("%s/api/v2.1/rest/update_message", s.APIBase())
+
+	fieldErrors := make(map[string][]string)
+	if req.UserID == "" {
+		fieldErrors["user_id"] = []string{"user_id is required"}
+	}
+	if req.CommentID == 0 && req.UniqueID == "" {
+		fieldErrors["comment_id"] = []string{"comment_id or unique_id is required"}
+	}
+	if len(fieldErrors) > 0 {
+		return resp, &qiscus.Error{
+			Message:     "update comment failed. invalid request",
+			FieldErrors: fieldErrors,
+		}
+	}
+
+	jsonReq, _ := json.Marshal(req)
+
+	r := s.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
+	err := qiscus.DoRequestContext(ctx, r)
+
+	return resp, err
+}
+
+// MarkCommentsRead mark the comments of a room as read by a user, up to a comment ID
+func (s *SDKImpl) MarkCommentsRead(req *MarkCommentsReq) (*MarkCommentsResponse, *qiscus.Error) {
+	return s.MarkCommentsReadContext(context.Background(), req)
+}
+
+// MarkCommentsReadContext mark the comments of a room as read by a user, up to a comment ID with context
+func (s *SDKImpl) MarkCommentsReadContext(ctx context.Context, req *MarkCommentsReq) (*MarkCommentsResponse, *qiscus.Error) {
+	return s.updateCommentStatus(ctx, req, "last_comment_read_id")
+}
+
+// MarkCommentsDelivered mark the comments of a room as delivered to a user, up to a comment ID
+func (s *SDKImpl) MarkCommentsDelivered(req *MarkCommentsReq) (*MarkCommentsResponse, *qiscus.Error) {
+	return s.MarkCommentsDeliveredContext(context.Background(), req)
+}
+
+// MarkCommentsDeliveredContext mark the comments of a room as delivered to a user, up to a comment ID with context
+func (s *SDKImpl) MarkCommentsDeliveredContext(ctx context.Context, req *MarkCommentsReq) (*MarkCommentsResponse, *qiscus.Error) {
+	return s.updateCommentStatus(ctx, req, "last_comment_received_id")
+}
+
+// updateCommentStatus moves the read or delivered comment pointer of the user, field is the pointer to move
+func (s *SDKImpl) updateCommentStatus(ctx context.Context, req *MarkCommentsReq, field string) (*MarkCommentsResponse, *qiscus.Error) {
+	resp := &MarkCommentsResponse{}
+	url := fmt.Sprintf("%s/api/v2.1/rest/update_comment_status", s.APIBase())
+
+	fieldErrors := make(map[string][]string)
+	if req.UserID == "" {
+		fieldErrors["user_id"] = []string{"user_id is required"}
+	}
+	if req.RoomID == "" {
+		fieldErrors["room_id"] = []string{"room_id is required"}
+	}
+	if req.CommentID <= 0 {
+		fieldErrors[field] = []string{"comment id is required"}
+	}
+	if len(fieldErrors) > 0 {
+		return resp, &qiscus.Error{
+			Message:     "update comment status failed. invalid request",
+			FieldErrors: fieldErrors,
+		}
+	}
+
+	jsonReq, _ := json.Marshal(map[string]interface{}{
+		"user_id": req.UserID,
+		"room_id": req.RoomID,
+		field:     req.CommentID,
+	})
+
+	r := s.newRequest(http.MethodPost, url, bytes.NewBuffer(jsonReq), resp)
+	err := qiscus.DoRequestContext(ctx, r)
+
+	return resp, err
+}
+
+// GetCommentReceipts get the read and delivered status of a comment for every participant of the room
+func (s *SDKImpl) GetCommentReceipts(req *GetCommentReceiptsReq) (*GetCommentReceiptsResponse, *qiscus.Error) {
+	return s.GetCommentReceiptsContext(context.Background(), req)
+}
+
+// GetCommentReceiptsContext get the read and delivered status of a comment for every participant of the room with context
+func (s *SDKImpl) GetCommentReceiptsContext(ctx context.Context, req *GetCommentReceiptsReq) (*GetCommentReceiptsResponse, *qiscus.Error) {
+	resp := &GetCommentReceiptsResponse{}
+	url := fmt.Sprintf("%s/api/v2.1/rest/comment_receipts", s.APIBase())
+
+	fieldErrors := make(map[string][]string)
+	if req.RoomID == "" {
+		fieldErrors["room_id"] = []string{"room_id is required"}
+	}
+	if req.CommentID <= 0 {
+		fieldErrors["comment_id"] = []string{"comment_id is required"}
+	}
+	if len(fieldErrors) > 0 {
+		return resp, &qiscus.Error{
+			Message:     "get comment receipts failed. invalid request",
+			FieldErrors: fieldErrors,
+		}
+	}
+
+	r := s.newRequest(http.MethodGet, url, nil, resp)
+	r.AddParameter("room_id", req.RoomID)
+	r.AddParameter("comment_id", strconv.Itoa(req.CommentID))
+	err := qiscus.DoRequestContext(ctx, r)
 
 	return resp, err
 }
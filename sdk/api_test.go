@@ -1,12 +1,17 @@
 package sdk
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/Qiscus-Integration/qiscus-go"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,6 +50,7 @@ func TestLoginOrRegister(t *testing.T) {
 	assert.Equal(t, result.Results.User.UserID, userID)
 	assert.Equal(t, result.Results.User.Username, userName)
 	assert.Equal(t, result.Results.User.AvatarURL, avatarURL)
+	assert.Equal(t, result.User(), result.Results.User)
 }
 
 func TestGetUserProfile(t *testing.T) {
@@ -391,6 +397,7 @@ func TestPostComment(t *testing.T) {
 	assert.Equal(t, result.Results.Comment.Message, message)
 	assert.Equal(t, result.Results.Comment.Type, "text")
 	assert.Equal(t, result.Results.Comment.User.UserID, userID)
+	assert.Equal(t, result.Comment().User.UserID, userID)
 }
 
 func TestLoadComments(t *testing.T) {
@@ -510,6 +517,7 @@ func TestGetUsers(t *testing.T) {
 	assert.Equal(t, result.Results.Users[0].Email, userEmail)
 	assert.Equal(t, result.Results.Users[0].Name, userName)
 	assert.Equal(t, result.Results.Users[0].AvatarURL, userAvatarURL)
+	assert.Equal(t, result.Users()[0].UserID, userEmail)
 }
 
 func TestLoadCommentsWithRange(t *testing.T) {
@@ -545,6 +553,22 @@ func TestLoadCommentsWithRange(t *testing.T) {
 	assert.Equal(t, result.Results.Comments[0].Message, firstCommentMessage)
 	assert.Equal(t, result.Results.Comments[1].ID, lastCommentID)
 	assert.Equal(t, result.Results.Comments[1].Message, lastCommentMessage)
+	assert.Len(t, result.Comments(), 2)
+}
+
+func TestLoadCommentsWithRangeTimestamp(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, `{"results":{"comments":[{"id":1,"message":"Message 1","timestamp":"2021-09-20 07:32:24"},{"id":2,"message":"Message 2","timestamp":"2021-09-20T07:33:24Z"}]}}`)
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	result, err := c.LoadCommentsWithRange(&LoadCommentsWithRangeReq{RoomID: roomID, FirstCommentID: "1", LastCommentID: "2"})
+	assert.Nil(t, err)
+	assert.True(t, result.Comments()[0].Timestamp.Equal(time.Date(2021, 9, 20, 7, 32, 24, 0, time.UTC)))
+	assert.True(t, result.Comments()[1].Timestamp.Equal(time.Date(2021, 9, 20, 7, 33, 24, 0, time.UTC)))
 }
 
 func TestGetOrCreateChannel(t *testing.T) {
@@ -611,6 +635,36 @@ func TestGetAverageReplyTimeUser(t *testing.T) {
 	assert.Equal(t, result.Results.Data.Duration.Average, average)
 }
 
+func TestGetUserResponseRate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.Method, http.MethodGet)
+		assert.Equal(t, req.URL.Path, "/api/v2.1/rest/get_user_response_rate")
+		assert.Equal(t, req.Header.Get("QISCUS_SDK_APP_ID"), qiscusAppID)
+		assert.Equal(t, req.Header.Get("QISCUS_SDK_SECRET"), qiscusSecretKey)
+		assert.Equal(t, req.URL.Query()["user_ids[]"], []string{"alice@mail.com", "bob@mail.com"})
+		assert.Equal(t, req.URL.Query().Get("start_time"), "2021-09-01 00:00:00")
+		assert.Equal(t, req.URL.Query().Get("end_time"), "2021-09-30 23:59:59")
+
+		rsp := `{"results":{"data":[{"user_id":"alice@mail.com","duration":{"average":10,"longest":20,"shortest":5}},{"user_id":"bob@mail.com","duration":{"average":30,"longest":60,"shortest":10}}],"start_time":"2021-09-01 00:00:00","end_time":"2021-09-30 23:59:59"}}`
+		fmt.Fprint(w, rsp)
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey)
+	c.SetAPIBase(srv.URL)
+
+	result, err := c.GetUserResponseRate(&GetUserResponseRateReq{
+		UserIDs:   []string{"alice@mail.com", "bob@mail.com"},
+		StartTime: time.Date(2021, 9, 1, 7, 0, 0, 0, time.FixedZone("WIB", 7*60*60)),
+		EndTime:   time.Date(2021, 9, 30, 23, 59, 59, 0, time.UTC),
+	})
+	assert.Nil(t, err)
+	assert.Len(t, result.Results.Data, 2)
+	assert.Equal(t, result.Results.StartTime.Time, time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, result.Results.Data[1].Duration, ReplyDuration{Average: 30, Longest: 60, Shortest: 10})
+}
+
 func TestGetWebhookLogs(t *testing.T) {
 	const (
 		webhookID    = 1
@@ -693,3 +747,208 @@ func TestReactivateUser(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, result.Results.Message, respMessage)
 }
+
+func TestPostCommentContextCanceled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		t.Error("request must not reach the server when context is canceled")
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey)
+	c.SetAPIBase(srv.URL)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := c.PostCommentContext(ctx, &PostCommentReq{
+		UserID:  "guest@mail.com",
+		RoomID:  roomID,
+		Message: "hello",
+		Type:    "text",
+	})
+	assert.NotNil(t, err)
+	assert.True(t, errors.Is(err.GetRawError(), context.Canceled))
+}
+
+func TestDeleteComments(t *testing.T) {
+	const userID = "guest@mail.com"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.Method, http.MethodDelete)
+		assert.Equal(t, req.URL.Path, "/api/v2.1/rest/delete_messages")
+		assert.Equal(t, req.Header.Get("QISCUS_SDK_APP_ID"), qiscusAppID)
+		assert.Equal(t, req.Header.Get("QISCUS_SDK_SECRET"), qiscusSecretKey)
+
+		var body map[string]interface{}
+		json.NewDecoder(req.Body).Decode(&body)
+		assert.Equal(t, body["user_id"], userID)
+		assert.Equal(t, body["comment_ids"], []interface{}{float64(1)})
+		assert.Equal(t, body["unique_ids"], []interface{}{"temp-2"})
+		assert.Equal(t, body["is_delete_for_everyone"], true)
+		assert.Equal(t, body["is_hard_delete"], false)
+
+		fmt.Fprint(w, `{"results":{"comments":[{"id":1,"is_deleted":true},{"id":2,"unique_id":"temp-2","is_deleted":true}]},"status":200}`)
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	result, err := c.DeleteComments(&DeleteCommentsReq{
+		UserID:      userID,
+		CommentIDs:  []int{1},
+		UniqueIDs:   []string{"temp-2"},
+		ForEveryone: true,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, result.Comments(), 2)
+	assert.True(t, result.Comments()[1].Deleted)
+
+	// Invalid requests are rejected without calling the API
+	_, err = c.DeleteComments(&DeleteCommentsReq{UserID: userID})
+	assert.True(t, errors.Is(err, qiscus.ErrValidation))
+	assert.Contains(t, err.GetFieldErrors(), "comment_ids")
+
+	_, err = c.DeleteComments(&DeleteCommentsReq{UserID: userID, CommentIDs: []int{1}, HardDelete: true})
+	assert.Contains(t, err.GetFieldErrors(), "is_hard_delete")
+}
+
+func TestClearRoomMessages(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.Method, http.MethodDelete)
+		assert.Equal(t, req.URL.Path, "/api/v2.1/rest/clear_room_messages")
+
+		var body ClearRoomMessagesReq
+		json.NewDecoder(req.Body).Decode(&body)
+		assert.Equal(t, body.RoomIDs, []string{roomID})
+
+		rsp := fmt.Sprintf(`{"results":{"rooms":[{"room_id":"%s"}]},"status":200}`, roomID)
+		fmt.Fprint(w, rsp)
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	result, err := c.ClearRoomMessages(&ClearRoomMessagesReq{RoomIDs: []string{roomID}})
+	assert.Nil(t, err)
+	assert.Equal(t, result.Rooms()[0].RoomID, roomID)
+
+	_, err = c.ClearRoomMessages(&ClearRoomMessagesReq{})
+	assert.True(t, errors.Is(err, qiscus.ErrValidation))
+}
+
+func TestUpdateComment(t *testing.T) {
+	const userID = "guest@mail.com"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.Method, http.MethodPost)
+		assert.Equal(t, req.URL.Path, "/api/v2.1/rest/update_message")
+
+		var body map[string]interface{}
+		json.NewDecoder(req.Body).Decode(&body)
+		assert.Equal(t, body["unique_id"], "temp-1")
+		assert.Equal(t, body["message"], "edited")
+		assert.NotContains(t, body, "comment_id")
+
+		fmt.Fprint(w, `{"results":{"comment":{"id":1,"unique_id":"temp-1","message":"edited","type":"text"}},"status":200}`)
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	result, err := c.UpdateComment(&UpdateCommentReq{UserID: userID, UniqueID: "temp-1", Message: "edited"})
+	assert.Nil(t, err)
+	assert.Equal(t, result.Comment().Message, "edited")
+
+	_, err = c.UpdateComment(&UpdateCommentReq{UserID: userID, Message: "edited"})
+	assert.True(t, errors.Is(err, qiscus.ErrValidation))
+	assert.Contains(t, err.GetFieldErrors(), "comment_id")
+}
+
+func TestMarkCommentsRead(t *testing.T) {
+	const userID = "guest@mail.com"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.Method, http.MethodPost)
+		assert.Equal(t, req.URL.Path, "/api/v2.1/rest/update_comment_status")
+		assert.Equal(t, req.Header.Get("QISCUS_SDK_APP_ID"), qiscusAppID)
+		assert.Equal(t, req.Header.Get("QISCUS_SDK_SECRET"), qiscusSecretKey)
+
+		var body map[string]interface{}
+		json.NewDecoder(req.Body).Decode(&body)
+		assert.Equal(t, body, map[string]interface{}{"user_id": userID, "room_id": roomID, "last_comment_read_id": float64(10)})
+
+		rsp := fmt.Sprintf(`{"results":{"user_id":"%s","room_id":"%s","last_comment_read_id":10,"last_comment_received_id":10},"status":200}`, userID, roomID)
+		fmt.Fprint(w, rsp)
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	result, err := c.MarkCommentsRead(&MarkCommentsReq{UserID: userID, RoomID: roomID, CommentID: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, result.Results.LastReadCommentID, 10)
+	assert.Equal(t, result.Results.LastDeliveredCommentID, 10)
+
+	_, err = c.MarkCommentsRead(&MarkCommentsReq{UserID: userID, RoomID: roomID})
+	assert.True(t, errors.Is(err, qiscus.ErrValidation))
+	assert.Contains(t, err.GetFieldErrors(), "last_comment_read_id")
+}
+
+func TestMarkCommentsDelivered(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.URL.Path, "/api/v2.1/rest/update_comment_status")
+
+		var body map[string]interface{}
+		json.NewDecoder(req.Body).Decode(&body)
+		assert.Equal(t, body["last_comment_received_id"], float64(10))
+		assert.NotContains(t, body, "last_comment_read_id")
+
+		fmt.Fprint(w, `{"results":{"last_comment_read_id":4,"last_comment_received_id":10},"status":200}`)
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	result, err := c.MarkCommentsDelivered(&MarkCommentsReq{UserID: "guest@mail.com", RoomID: roomID, CommentID: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, result.Results.LastReadCommentID, 4)
+	assert.Equal(t, result.Results.LastDeliveredCommentID, 10)
+}
+
+func TestGetCommentReceipts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.Method, http.MethodGet)
+		assert.Equal(t, req.URL.Path, "/api/v2.1/rest/comment_receipts")
+		assert.Equal(t, req.URL.Query().Get("room_id"), roomID)
+		assert.Equal(t, req.URL.Query().Get("comment_id"), "10")
+
+		fmt.Fprint(w, `{"results":{"receipts":[
+			{"user":{"user_id":"alice"},"status":"read","last_comment_read_id":12,"last_comment_received_id":12},
+			{"user":{"user_id":"bob"},"status":"delivered","last_comment_read_id":8,"last_comment_received_id":10},
+			{"user":{"user_id":"carol"},"status":"sent","last_comment_read_id":0,"last_comment_received_id":0}
+		]},"status":200}`)
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	result, err := c.GetCommentReceipts(&GetCommentReceiptsReq{RoomID: roomID, CommentID: 10})
+	assert.Nil(t, err)
+	assert.Len(t, result.Receipts(), 3)
+	assert.Equal(t, result.Receipts()[1].Status, ReceiptStatusDelivered)
+	assert.Equal(t, result.ReadBy(), []User{{UserID: "alice"}})
+	assert.Equal(t, result.DeliveredTo(), []User{{UserID: "alice"}, {UserID: "bob"}})
+
+	// Invalid request is rejected before sending
+	_, err = c.GetCommentReceipts(&GetCommentReceiptsReq{})
+	assert.True(t, errors.Is(err, qiscus.ErrValidation))
+	assert.Contains(t, err.GetFieldErrors(), "room_id")
+	assert.Contains(t, err.GetFieldErrors(), "comment_id")
+}
@@ -1,10 +1,15 @@
 package sdk
 
 import (
+	"context"
 	"errors"
+	"io"
+	"net/http"
 	"os"
+	"time"
 
 	"github.com/Qiscus-Integration/qiscus-go"
+	"github.com/rs/zerolog"
 )
 
 // APIBase is base url the library uses to contact multichannel. Use SetAPIBase() to override
@@ -16,30 +21,69 @@ type SDK interface {
 	QiscusAppID() string
 	QiscusSecretKey() string
 	SetAPIBase(address string)
+	SetRetryPolicy(policy *qiscus.RetryPolicy)
 
 	LoginOrRegister(req *LoginOrRegisterReq) (*LoginOrRegisterResponse, *qiscus.Error)
+	LoginOrRegisterContext(ctx context.Context, req *LoginOrRegisterReq) (*LoginOrRegisterResponse, *qiscus.Error)
 	GetUserProfile(userID string) (*GetUserProfileResponse, *qiscus.Error)
+	GetUserProfileContext(ctx context.Context, userID string) (*GetUserProfileResponse, *qiscus.Error)
 	GetUserToken(userID string) (*GetUserTokenResponse, *qiscus.Error)
+	GetUserTokenContext(ctx context.Context, userID string) (*GetUserTokenResponse, *qiscus.Error)
 	ResetUserToken(userID string) (*GetUserTokenResponse, *qiscus.Error)
+	ResetUserTokenContext(ctx context.Context, userID string) (*GetUserTokenResponse, *qiscus.Error)
 	CreateRoom(req *CreateRoomReq) (*CreateRoomResponse, *qiscus.Error)
+	CreateRoomContext(ctx context.Context, req *CreateRoomReq) (*CreateRoomResponse, *qiscus.Error)
 	GetOrCreateRoomWithTarget(req *GetOrCreateRoomWithTargetReq) (*CreateRoomResponse, *qiscus.Error)
+	GetOrCreateRoomWithTargetContext(ctx context.Context, req *GetOrCreateRoomWithTargetReq) (*CreateRoomResponse, *qiscus.Error)
 	GetRoomsInfo(roomIDs []string) (*GetRoomsInfoResponse, *qiscus.Error)
+	GetRoomsInfoContext(ctx context.Context, roomIDs []string) (*GetRoomsInfoResponse, *qiscus.Error)
 	UpdateRoom(req *UpdateRoomReq) (*UpdateRoomResponse, *qiscus.Error)
+	UpdateRoomContext(ctx context.Context, req *UpdateRoomReq) (*UpdateRoomResponse, *qiscus.Error)
 	GetRoomParticipants(req *GetRoomParticipantsReq) (*GetRoomParticipantsResponse, *qiscus.Error)
+	GetRoomParticipantsContext(ctx context.Context, req *GetRoomParticipantsReq) (*GetRoomParticipantsResponse, *qiscus.Error)
 	AddRoomParticipants(req *AddRoomParticipantsReq) (*AddRoomParticipantsResponse, *qiscus.Error)
+	AddRoomParticipantsContext(ctx context.Context, req *AddRoomParticipantsReq) (*AddRoomParticipantsResponse, *qiscus.Error)
 	RemoveRoomParticipants(req *RemoveRoomParticipantsReq) (*RemoveRoomParticipantsResponse, *qiscus.Error)
+	RemoveRoomParticipantsContext(ctx context.Context, req *RemoveRoomParticipantsReq) (*RemoveRoomParticipantsResponse, *qiscus.Error)
 	GetUserRooms(req *GetUserRoomsReq) (*GetUserRoomsResponse, *qiscus.Error)
+	GetUserRoomsContext(ctx context.Context, req *GetUserRoomsReq) (*GetUserRoomsResponse, *qiscus.Error)
 	PostComment(req *PostCommentReq) (*PostCommentResponse, *qiscus.Error)
+	PostCommentContext(ctx context.Context, req *PostCommentReq) (*PostCommentResponse, *qiscus.Error)
 	LoadComments(req *LoadCommentsReq) (*LoadCommentsResponse, *qiscus.Error)
+	LoadCommentsContext(ctx context.Context, req *LoadCommentsReq) (*LoadCommentsResponse, *qiscus.Error)
 	PostSystemEventMessage(req *PostSystemEventMessageReq) (*PostSystemEventMessageResponse, *qiscus.Error)
+	PostSystemEventMessageContext(ctx context.Context, req *PostSystemEventMessageReq) (*PostSystemEventMessageResponse, *qiscus.Error)
 	GetUnreadCount(req *GetUnreadCountReq) (*GetUnreadCountResponse, *qiscus.Error)
+	GetUnreadCountContext(ctx context.Context, req *GetUnreadCountReq) (*GetUnreadCountResponse, *qiscus.Error)
 	GetUsers(req *GetUsersReq) (*GetUsersResponse, *qiscus.Error)
+	GetUsersContext(ctx context.Context, req *GetUsersReq) (*GetUsersResponse, *qiscus.Error)
 	LoadCommentsWithRange(req *LoadCommentsWithRangeReq) (*LoadCommentsWithRangeResponse, *qiscus.Error)
+	LoadCommentsWithRangeContext(ctx context.Context, req *LoadCommentsWithRangeReq) (*LoadCommentsWithRangeResponse, *qiscus.Error)
 	GetOrCreateChannel(req *GetOrCreateChannelReq) (*GetOrCreateChannelResponse, *qiscus.Error)
+	GetOrCreateChannelContext(ctx context.Context, req *GetOrCreateChannelReq) (*GetOrCreateChannelResponse, *qiscus.Error)
 	GetAverageReplyTimeUser(req *GetAverageReplyTimeUserReq) (*GetAverageReplyTimeUserResponse, *qiscus.Error)
+	GetAverageReplyTimeUserContext(ctx context.Context, req *GetAverageReplyTimeUserReq) (*GetAverageReplyTimeUserResponse, *qiscus.Error)
+	GetUserResponseRate(req *GetUserResponseRateReq) (*GetUserResponseRateResponse, *qiscus.Error)
+	GetUserResponseRateContext(ctx context.Context, req *GetUserResponseRateReq) (*GetUserResponseRateResponse, *qiscus.Error)
 	GetWebhookLogs(req *GetWebhookLogsReq) (*GetWebhookLogsResponse, *qiscus.Error)
+	GetWebhookLogsContext(ctx context.Context, req *GetWebhookLogsReq) (*GetWebhookLogsResponse, *qiscus.Error)
 	DeactivateUser(req *DeactivateUserReq) (*DeactivateUserResponse, *qiscus.Error)
+	DeactivateUserContext(ctx context.Context, req *DeactivateUserReq) (*DeactivateUserResponse, *qiscus.Error)
 	ReactivateUser(req *ReactivateUserReq) (*ReactivateUserResponse, *qiscus.Error)
+	ReactivateUserContext(ctx context.Context, req *ReactivateUserReq) (*ReactivateUserResponse, *qiscus.Error)
+	DeleteComments(req *DeleteCommentsReq) (*DeleteCommentsResponse, *qiscus.Error)
+	DeleteCommentsContext(ctx context.Context, req *DeleteCommentsReq) (*DeleteCommentsResponse, *qiscus.Error)
+	ClearRoomMessages(req *ClearRoomMessagesReq) (*ClearRoomMessagesResponse, *qiscus.Error)
+	ClearRoomMessagesContext(ctx context.Context, req *ClearRoomMessagesReq) (*ClearRoomMessagesResponse, *qiscus.Error)
+	UpdateComment(req *UpdateCommentReq) (*UpdateCommentResponse, *qiscus.Error)
+	UpdateCommentContext(ctx context.Context, req *UpdateCommentReq) (*UpdateCommentResponse, *qiscus.Error)
+	MarkCommentsRead(req *MarkCommentsReq) (*MarkCommentsResponse, *qiscus.Error)
+	MarkCommentsReadContext(ctx context.Context, req *MarkCommentsReq) (*MarkCommentsResponse, *qiscus.Error)
+	MarkCommentsDelivered(req *MarkCommentsReq) (*MarkCommentsResponse, *qiscus.Error)
+	MarkCommentsDeliveredContext(ctx context.Context, req *MarkCommentsReq) (*MarkCommentsResponse, *qiscus.Error)
+	GetCommentReceipts(req *GetCommentReceiptsReq) (*GetCommentReceiptsResponse, *qiscus.Error)
+	GetCommentReceiptsContext(ctx context.Context, req *GetCommentReceiptsReq) (*GetCommentReceiptsResponse, *qiscus.Error)
+	Upload(ctx context.Context, filename string, r io.Reader, opts ...UploadOption) (*UploadResponse, *qiscus.Error)
 }
 
 // SDKImpl bundles data needed by a large number of methods in order to interact with the SDK API.
@@ -47,20 +91,44 @@ type SDKImpl struct {
 	apiBase         string
 	qiscusAppID     string
 	qiscusSecretKey string
+	retryPolicy     *qiscus.RetryPolicy
+	retryPolicySet  bool // the retry policy overrides qiscus.DefaultRetryPolicy, even when nil
+	httpClient      *http.Client
+	timeout         time.Duration
+	logger          *zerolog.Logger
+	userAgent       string
 }
 
 // NewSDK creates a new client instance
-func NewSDK(qiscusAppID, qiscusSecretKey string) SDK {
-	return &SDKImpl{
+func NewSDK(qiscusAppID, qiscusSecretKey string, opts ...Option) SDK {
+	s := &SDKImpl{
 		apiBase:         APIBase,
 		qiscusAppID:     qiscusAppID,
 		qiscusSecretKey: qiscusSecretKey,
 	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	// Apply the timeout on a copy, so the given or default HTTP client is left untouched
+	if s.timeout > 0 {
+		httpClient := qiscus.DefaultHttpClient
+		if s.httpClient != nil {
+			httpClient = s.httpClient
+		}
+
+		c := *httpClient
+		c.Timeout = s.timeout
+		s.httpClient = &c
+	}
+
+	return s
 }
 
 // NewSDKFromEnv returns a new SDK client using the environment variables
 // QISCUS_APP_ID, QISCUS_SECRET_KEY and QISCUS_API_BASE
-func NewSDKFromEnv() (SDK, error) {
+func NewSDKFromEnv(opts ...Option) (SDK, error) {
 	qiscusAppID := os.Getenv("QISCUS_APP_ID")
 	if qiscusAppID == "" {
 		return nil, errors.New("required environment variable QISCUS_APP_ID not defined")
@@ -71,13 +139,13 @@ func NewSDKFromEnv() (SDK, error) {
 		return nil, errors.New("required environment variable QISCUS_SECRET_KEY not defined")
 	}
 
-	s := NewSDK(qiscusAppID, qiscusSecretKey)
-
-	url := os.Getenv("QISCUS_API_BASE")
-	if url != "" {
-		s.SetAPIBase(url)
+	// The environment sets the defaults, the given options take precedence
+	if url := os.Getenv("QISCUS_API_BASE"); url != "" {
+		opts = append([]Option{WithAPIBase(url)}, opts...)
 	}
 
+	s := NewSDK(qiscusAppID, qiscusSecretKey, opts...)
+
 	return s, nil
 }
 
@@ -101,3 +169,40 @@ func (s *SDKImpl) QiscusSecretKey() string {
 func (s *SDKImpl) SetAPIBase(address string) {
 	s.apiBase = address
 }
+
+// SetRetryPolicy sets the retry policy used by every request of this client, overriding qiscus.DefaultRetryPolicy.
+// Set a custom retry policy: s.SetRetryPolicy(qiscus.NewRetryPolicy())
+func (s *SDKImpl) SetRetryPolicy(policy *qiscus.RetryPolicy) {
+	s.retryPolicy = policy
+	s.retryPolicySet = true
+}
+
+// newRequest creates a request authenticated with the credentials of this client
+func (s *SDKImpl) newRequest(method, url string, body io.Reader, resp interface{}) qiscus.HttpRequest {
+	r := qiscus.NewHttpRequest(method, url, body, resp)
+	r.AddHeader("QISCUS_SDK_APP_ID", s.QiscusAppID())
+	r.AddHeader("QISCUS_SDK_SECRET", s.QiscusSecretKey())
+
+	settings, ok := r.(qiscus.HttpRequestSettings)
+	if !ok {
+		return r
+	}
+
+	if s.retryPolicySet {
+		settings.SetRetryPolicy(s.retryPolicy)
+	}
+
+	if s.httpClient != nil {
+		settings.SetHttpClient(s.httpClient)
+	}
+
+	if s.userAgent != "" {
+		settings.SetUserAgent(s.userAgent)
+	}
+
+	if s.logger != nil {
+		settings.SetLogger(s.logger)
+	}
+
+	return r
+}
@@ -1,8 +1,18 @@
 package sdk
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/Qiscus-Integration/qiscus-go"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -16,3 +26,136 @@ func TestNewSDK(t *testing.T) {
 	assert.Equal(t, c.QiscusAppID(), qiscusAppID)
 	assert.Equal(t, c.QiscusSecretKey(), qiscusSecretKey)
 }
+
+func TestSetRetryPolicy(t *testing.T) {
+	var attempts int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		fmt.Fprint(w, `{"results":{"token":"token-123"}}`)
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey)
+	c.SetAPIBase(srv.URL)
+	c.SetRetryPolicy(&qiscus.RetryPolicy{
+		MaxAttempts:          3,
+		InitialBackoff:       time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusServiceUnavailable},
+	})
+
+	// GET is idempotent, so it is retried until it succeeds
+	result, err := c.GetUserToken("guest@mail.com")
+	assert.Nil(t, err)
+	assert.Equal(t, result.Results.Token, "token-123")
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+
+	// POST is sent only once unless retry is explicitly allowed
+	atomic.StoreInt32(&attempts, 0)
+	_, err = c.PostComment(&PostCommentReq{RoomID: "123123", Message: "hello", Type: "text"})
+	assert.NotNil(t, err)
+	assert.Equal(t, err.GetStatusCode(), http.StatusServiceUnavailable)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+
+	atomic.StoreInt32(&attempts, 0)
+	_, err = c.PostCommentContext(qiscus.AllowRetry(context.Background()), &PostCommentReq{RoomID: "123123", Message: "hello", Type: "text"})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestNewSDKWithOptions(t *testing.T) {
+	const userAgent = "my-service/1.0"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.Header.Get("User-Agent"), userAgent)
+
+		fmt.Fprint(w, `{"results":{"token":"token-123"}}`)
+	}))
+
+	defer srv.Close()
+
+	var logs bytes.Buffer
+	c := NewSDK(qiscusAppID, qiscusSecretKey,
+		WithAPIBase(srv.URL),
+		WithHTTPClient(srv.Client()),
+		WithTimeout(5*time.Second),
+		WithUserAgent(userAgent),
+		WithLogger(zerolog.New(&logs)),
+	)
+	assert.Equal(t, c.APIBase(), srv.URL)
+
+	result, err := c.GetUserToken("guest@mail.com")
+	assert.Nil(t, err)
+	assert.Equal(t, result.Results.Token, "token-123")
+	assert.Contains(t, logs.String(), "OUTBOUND LOG")
+
+	// The timeout is applied on a copy of the given HTTP client
+	assert.Equal(t, srv.Client().Timeout, time.Duration(0))
+	assert.Equal(t, c.(*SDKImpl).httpClient.Timeout, 5*time.Second)
+	assert.Equal(t, qiscus.DefaultHttpClient.Timeout, qiscus.DefaultHttpTimeout)
+}
+
+func TestWithRetryPolicyNil(t *testing.T) {
+	var attempts int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+
+	defer srv.Close()
+
+	defaultRetryPolicy := qiscus.DefaultRetryPolicy
+	defer func() { qiscus.DefaultRetryPolicy = defaultRetryPolicy }()
+
+	qiscus.DefaultRetryPolicy = &qiscus.RetryPolicy{
+		MaxAttempts:          3,
+		InitialBackoff:       time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusServiceUnavailable},
+	}
+
+	// The global retry policy applies to clients without their own
+	_, err := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL)).GetUserToken("guest@mail.com")
+	assert.NotNil(t, err)
+	assert.Equal(t, atomic.LoadInt32(&attempts), int32(3))
+
+	// A nil retry policy opts the client out of the global one
+	atomic.StoreInt32(&attempts, 0)
+	_, err = NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL), WithRetryPolicy(nil)).GetUserToken("guest@mail.com")
+	assert.NotNil(t, err)
+	assert.Equal(t, atomic.LoadInt32(&attempts), int32(1))
+}
+
+func TestNewSDKFromEnv(t *testing.T) {
+	for name, value := range map[string]string{
+		"QISCUS_APP_ID":     qiscusAppID,
+		"QISCUS_SECRET_KEY": qiscusSecretKey,
+		"QISCUS_API_BASE":   "https://api-env.qiscus.com",
+	} {
+		old, ok := os.LookupEnv(name)
+		os.Setenv(name, value)
+		defer func(name string) {
+			if ok {
+				os.Setenv(name, old)
+			} else {
+				os.Unsetenv(name)
+			}
+		}(name)
+	}
+
+	c, err := NewSDKFromEnv()
+	assert.Nil(t, err)
+	assert.Equal(t, c.QiscusAppID(), qiscusAppID)
+	assert.Equal(t, c.APIBase(), "https://api-env.qiscus.com")
+
+	// An explicit option takes precedence over the environment
+	c, err = NewSDKFromEnv(WithAPIBase("https://api-option.qiscus.com"))
+	assert.Nil(t, err)
+	assert.Equal(t, c.APIBase(), "https://api-option.qiscus.com")
+}
@@ -0,0 +1,395 @@
+package sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Comment types supported by Qiscus SDK
+const (
+	CommentTypeText           = "text"
+	CommentTypeFileAttachment = "file_attachment"
+	CommentTypeButtons        = "buttons"
+	CommentTypeCard           = "card"
+	CommentTypeCarousel       = "carousel"
+	CommentTypeLocation       = "location"
+	CommentTypeContactPerson  = "contact_person"
+	CommentTypeReply          = "reply"
+	CommentTypeSystemEvent    = "system_event"
+	CommentTypeCustom         = "custom"
+)
+
+// Button types
+const (
+	ButtonTypeLink     = "link"
+	ButtonTypePostback = "postback"
+)
+
+// Contact person types
+const (
+	ContactTypePhone = "phone"
+	ContactTypeEmail = "email"
+)
+
+// ErrInvalidMessage is returned by the message builders when the payload is not valid
+var ErrInvalidMessage = errors.New("qiscus: invalid message")
+
+// Button is Represent a button of buttons and card payload
+type Button struct {
+	Label        string        `json:"label"`
+	Type         string        `json:"type"` // ButtonTypeLink or ButtonTypePostback
+	PostbackText string        `json:"postback_text,omitempty"`
+	Payload      ButtonPayload `json:"payload"`
+}
+
+// ButtonPayload is Represent the action of a button
+type ButtonPayload struct {
+	URL     string      `json:"url,omitempty"`
+	Method  string      `json:"method,omitempty"`
+	Payload interface{} `json:"payload"`
+}
+
+// ButtonsPayload is Represent buttons comment payload
+type ButtonsPayload struct {
+	Text    string   `json:"text"`
+	Buttons []Button `json:"buttons"`
+}
+
+// Card is Represent card comment payload, also used as a card of carousel payload
+type Card struct {
+	Text          string   `json:"text"`
+	Image         string   `json:"image"`
+	Title         string   `json:"title"`
+	Description   string   `json:"description"`
+	URL           string   `json:"url"`
+	DefaultAction *Button  `json:"default_action,omitempty"`
+	Buttons       []Button `json:"buttons,omitempty"`
+}
+
+// CarouselPayload is Represent carousel comment payload
+type CarouselPayload struct {
+	Cards []Card `json:"cards"`
+}
+
+// LocationPayload is Represent location comment payload
+type LocationPayload struct {
+	Name      string  `json:"name"`
+	Address   string  `json:"address"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	MapURL    string  `json:"map_url"`
+}
+
+// ContactPersonPayload is Represent contact person comment payload
+type ContactPersonPayload struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	Type  string `json:"type"` // ContactTypePhone or ContactTypeEmail
+}
+
+// FileAttachmentPayload is Represent file attachment comment payload
+type FileAttachmentPayload struct {
+	URL           string `json:"url"`
+	Caption       string `json:"caption"`
+	FileName      string `json:"file_name"`
+	Size          int64  `json:"size,omitempty"`
+	Pages         int    `json:"pages,omitempty"`
+	EncryptionKey string `json:"encryption_key,omitempty"`
+}
+
+// ReplyPayload is Represent reply comment payload
+type ReplyPayload struct {
+	Text                          string          `json:"text"`
+	RepliedCommentID              int             `json:"replied_comment_id"`
+	RepliedCommentMessage         string          `json:"replied_comment_message"`
+	RepliedCommentType            string          `json:"replied_comment_type"`
+	RepliedCommentPayload         json.RawMessage `json:"replied_comment_payload,omitempty"`
+	RepliedCommentSenderEmail     string          `json:"replied_comment_sender_email"`
+	RepliedCommentSenderUsername  string          `json:"replied_comment_sender_username"`
+	RepliedCommentIsDeleted       bool            `json:"replied_comment_is_deleted,omitempty"`
+	RepliedCommentSenderAvatarURL string          `json:"replied_comment_sender_avatar_url,omitempty"`
+}
+
+// SystemEventPayload is Represent system event comment payload
+type SystemEventPayload struct {
+	Type               string          `json:"type"`
+	SubjectUsername    string          `json:"subject_username"`
+	SubjectEmail       string          `json:"subject_email"`
+	ObjectUsername     string          `json:"object_username"`
+	ObjectEmail        string          `json:"object_email"`
+	ObjectUsernameList []string        `json:"object_username_list"`
+	ObjectEmailList    []string        `json:"object_email_list"`
+	RoomName           string          `json:"room_name"`
+	Payload            json.RawMessage `json:"payload,omitempty"` // custom system event payload
+}
+
+// CustomPayload is Represent custom comment payload, Type is the application defined type
+type CustomPayload struct {
+	Type    string      `json:"type"`
+	Content interface{} `json:"content"`
+}
+
+// NewTextMessage creates a text comment request
+func NewTextMessage(userID, roomID, text string) (*PostCommentReq, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, invalidMessage("text is required")
+	}
+
+	return newMessage(userID, roomID, CommentTypeText, text, nil)
+}
+
+// NewButtonsMessage creates a buttons comment request
+func NewButtonsMessage(userID, roomID, text string, buttons ...Button) (*PostCommentReq, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, invalidMessage("text is required")
+	}
+	if len(buttons) == 0 {
+		return nil, invalidMessage("at least one button is required")
+	}
+	if err := validateButtons(buttons); err != nil {
+		return nil, err
+	}
+
+	return newMessage(userID, roomID, CommentTypeButtons, text, &ButtonsPayload{Text: text, Buttons: buttons})
+}
+
+// NewCardMessage creates a card comment request
+func NewCardMessage(userID, roomID string, card Card) (*PostCommentReq, error) {
+	if err := validateCard(card); err != nil {
+		return nil, err
+	}
+
+	return newMessage(userID, roomID, CommentTypeCard, cardText(card), &card)
+}
+
+// NewCarouselMessage creates a carousel comment request
+func NewCarouselMessage(userID, roomID string, cards ...Card) (*PostCommentReq, error) {
+	if len(cards) == 0 {
+		return nil, invalidMessage("at least one card is required")
+	}
+	for i, card := range cards {
+		if err := validateCard(card); err != nil {
+			return nil, fmt.Errorf("card %d: %w", i, err)
+		}
+	}
+
+	return newMessage(userID, roomID, CommentTypeCarousel, cardText(cards[0]), &CarouselPayload{Cards: cards})
+}
+
+// NewLocationMessage creates a location comment request
+func NewLocationMessage(userID, roomID string, location LocationPayload) (*PostCommentReq, error) {
+	if location.Latitude < -90 || location.Latitude > 90 || location.Longitude < -180 || location.Longitude > 180 {
+		return nil, invalidMessage("latitude or longitude is out of range")
+	}
+	if location.MapURL == "" {
+		location.MapURL = fmt.Sprintf("http://maps.google.com/?q=%v,%v", location.Latitude, location.Longitude)
+	}
+
+	text := location.Name
+	if text == "" {
+		text = location.MapURL
+	}
+
+	return newMessage(userID, roomID, CommentTypeLocation, text, &location)
+}
+
+// NewContactPersonMessage creates a contact person comment request
+func NewContactPersonMessage(userID, roomID string, contact ContactPersonPayload) (*PostCommentReq, error) {
+	if contact.Name == "" || contact.Value == "" {
+		return nil, invalidMessage("contact name and value are required")
+	}
+	if contact.Type == "" {
+		contact.Type = ContactTypePhone
+	}
+	if contact.Type != ContactTypePhone && contact.Type != ContactTypeEmail {
+		return nil, invalidMessage(fmt.Sprintf("unknown contact type %q", contact.Type))
+	}
+
+	return newMessage(userID, roomID, CommentTypeContactPerson, contact.Name+" - "+contact.Value, &contact)
+}
+
+// NewFileAttachmentMessage creates a file attachment comment request
+func NewFileAttachmentMessage(userID, roomID string, file FileAttachmentPayload) (*PostCommentReq, error) {
+	if !strings.HasPrefix(file.URL, "http://") && !strings.HasPrefix(file.URL, "https://") {
+		return nil, invalidMessage("file url must be an absolute http url")
+	}
+	if file.FileName == "" {
+		file.FileName = file.URL[strings.LastIndex(file.URL, "/")+1:]
+	}
+
+	// Clients without file attachment support show the file message
+	return newMessage(userID, roomID, CommentTypeFileAttachment, "[file] "+file.URL+" [/file]", &file)
+}
+
+// NewReplyMessage creates a comment request replying to comment
+func NewReplyMessage(userID, roomID, text string, replied Comment) (*PostCommentReq, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, invalidMessage("text is required")
+	}
+	if replied.ID == 0 {
+		return nil, invalidMessage("replied comment id is required")
+	}
+
+	payload := replied.RawPayload
+	if len(payload) == 0 && replied.Payload != nil {
+		var err error
+		if payload, err = json.Marshal(replied.Payload); err != nil {
+			return nil, err
+		}
+	}
+
+	return newMessage(userID, roomID, CommentTypeReply, text, &ReplyPayload{
+		Text:                          text,
+		RepliedCommentID:              replied.ID,
+		RepliedCommentMessage:         replied.Message,
+		RepliedCommentType:            replied.Type,
+		RepliedCommentPayload:         payload,
+		RepliedCommentSenderEmail:     replied.User.UserID,
+		RepliedCommentSenderUsername:  replied.User.Username,
+		RepliedCommentSenderAvatarURL: replied.User.AvatarURL,
+	})
+}
+
+// NewCustomMessage creates a custom comment request, customType is the application defined type of content
+func NewCustomMessage(userID, roomID, text, customType string, content interface{}) (*PostCommentReq, error) {
+	if customType == "" {
+		return nil, invalidMessage("custom type is required")
+	}
+
+	return newMessage(userID, roomID, CommentTypeCustom, text, &CustomPayload{Type: customType, Content: content})
+}
+
+// DecodePayload decodes the payload of a comment of commentType into its payload type,
+// e.g. *ButtonsPayload for CommentTypeButtons. Text payload is decoded to nil,
+// and payload of unknown types is returned as json.RawMessage.
+func DecodePayload(commentType string, payload json.RawMessage) (interface{}, error) {
+	var v interface{}
+	switch commentType {
+	case CommentTypeText:
+		return nil, nil
+	case CommentTypeButtons:
+		v = &ButtonsPayload{}
+	case CommentTypeCard:
+		v = &Card{}
+	case CommentTypeCarousel:
+		v = &CarouselPayload{}
+	case CommentTypeLocation:
+		v = &LocationPayload{}
+	case CommentTypeContactPerson:
+		v = &ContactPersonPayload{}
+	case CommentTypeFileAttachment:
+		v = &FileAttachmentPayload{}
+	case CommentTypeReply:
+		v = &ReplyPayload{}
+	case CommentTypeSystemEvent:
+		v = &SystemEventPayload{}
+	case CommentTypeCustom:
+		v = &CustomPayload{}
+	default:
+		return payload, nil
+	}
+
+	if len(payload) == 0 || string(payload) == "null" {
+		return v, nil
+	}
+	if err := json.Unmarshal(payload, v); err != nil {
+		return nil, fmt.Errorf("invalid %s payload: %s", commentType, err.Error())
+	}
+
+	return v, nil
+}
+
+// UnmarshalJSON decodes a comment and its payload according to its type.
+// A payload not matching its type is kept as json.RawMessage, so one malformed comment does not fail a whole page.
+func (c *Comment) UnmarshalJSON(data []byte) error {
+	type comment Comment
+	if err := json.Unmarshal(data, (*comment)(c)); err != nil {
+		return err
+	}
+
+	payload, err := DecodePayload(c.Type, c.RawPayload)
+	if err != nil {
+		payload = c.RawPayload
+	}
+	c.Payload = payload
+
+	return nil
+}
+
+// MarshalJSON encodes a comment, with Payload when the raw payload is not set
+func (c Comment) MarshalJSON() ([]byte, error) {
+	type comment Comment
+	if len(c.RawPayload) == 0 && c.Payload != nil {
+		raw, err := json.Marshal(c.Payload)
+		if err != nil {
+			return nil, err
+		}
+		c.RawPayload = raw
+	}
+
+	return json.Marshal(comment(c))
+}
+
+func newMessage(userID, roomID, commentType, text string, payload interface{}) (*PostCommentReq, error) {
+	if userID == "" || roomID == "" {
+		return nil, invalidMessage("user id and room id are required")
+	}
+
+	req := &PostCommentReq{
+		UserID:  userID,
+		RoomID:  roomID,
+		Message: text,
+		Type:    commentType,
+	}
+	if payload != nil {
+		req.Payload = payload
+	}
+
+	return req, nil
+}
+
+func validateButtons(buttons []Button) error {
+	for i, b := range buttons {
+		if b.Label == "" {
+			return invalidMessage(fmt.Sprintf("button %d label is required", i))
+		}
+
+		switch b.Type {
+		case ButtonTypeLink:
+			if b.Payload.URL == "" {
+				return invalidMessage(fmt.Sprintf("button %d is a link without url", i))
+			}
+		case ButtonTypePostback:
+		default:
+			return invalidMessage(fmt.Sprintf("button %d has unknown type %q", i, b.Type))
+		}
+	}
+
+	return nil
+}
+
+func validateCard(card Card) error {
+	if card.Title == "" {
+		return invalidMessage("card title is required")
+	}
+	if card.DefaultAction != nil {
+		if err := validateButtons([]Button{*card.DefaultAction}); err != nil {
+			return err
+		}
+	}
+
+	return validateButtons(card.Buttons)
+}
+
+func cardText(card Card) string {
+	if card.Text != "" {
+		return card.Text
+	}
+	return card.Title
+}
+
+func invalidMessage(reason string) error {
+	return fmt.Errorf("%w: %s", ErrInvalidMessage, reason)
+}
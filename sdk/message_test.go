@@ -0,0 +1,155 @@
+package sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessageBuilders(t *testing.T) {
+	link := Button{Label: "Open", Type: ButtonTypeLink, Payload: ButtonPayload{URL: "https://example.com"}}
+	postback := Button{Label: "Yes", Type: ButtonTypePostback, PostbackText: "yes"}
+	card := Card{Title: "Product", Image: "https://example.com/image.png", Buttons: []Button{link}}
+
+	replied := Comment{ID: 10, Message: "hello", Type: CommentTypeText}
+	replied.User.UserID = "guest@mail.com"
+
+	tests := []struct {
+		name    string
+		build   func() (*PostCommentReq, error)
+		typ     string
+		message string
+		payload string
+	}{
+		{
+			name:    "buttons",
+			build:   func() (*PostCommentReq, error) { return NewButtonsMessage("bot", roomID, "Choose", link, postback) },
+			typ:     CommentTypeButtons,
+			message: "Choose",
+			payload: `{"text":"Choose","buttons":[{"label":"Open","type":"link","payload":{"url":"https://example.com","payload":null}},{"label":"Yes","type":"postback","postback_text":"yes","payload":{"payload":null}}]}`,
+		},
+		{
+			name:    "carousel",
+			build:   func() (*PostCommentReq, error) { return NewCarouselMessage("bot", roomID, card, card) },
+			typ:     CommentTypeCarousel,
+			message: "Product",
+		},
+		{
+			name: "file attachment",
+			build: func() (*PostCommentReq, error) {
+				return NewFileAttachmentMessage("bot", roomID, FileAttachmentPayload{URL: "https://example.com/files/invoice.pdf", Caption: "Invoice"})
+			},
+			typ:     CommentTypeFileAttachment,
+			message: "[file] https://example.com/files/invoice.pdf [/file]",
+			payload: `{"url":"https://example.com/files/invoice.pdf","caption":"Invoice","file_name":"invoice.pdf"}`,
+		},
+		{
+			name:    "reply",
+			build:   func() (*PostCommentReq, error) { return NewReplyMessage("bot", roomID, "hi", replied) },
+			typ:     CommentTypeReply,
+			message: "hi",
+		},
+		{
+			name: "location",
+			build: func() (*PostCommentReq, error) {
+				return NewLocationMessage("bot", roomID, LocationPayload{Latitude: -6.2, Longitude: 106.8})
+			},
+			typ:     CommentTypeLocation,
+			message: "http://maps.google.com/?q=-6.2,106.8",
+		},
+		{
+			name: "custom",
+			build: func() (*PostCommentReq, error) {
+				return NewCustomMessage("bot", roomID, "promo", "promo_banner", map[string]interface{}{"code": "SALE"})
+			},
+			typ:     CommentTypeCustom,
+			message: "promo",
+			payload: `{"type":"promo_banner","content":{"code":"SALE"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := tt.build()
+			assert.Nil(t, err)
+			assert.Equal(t, req.Type, tt.typ)
+			assert.Equal(t, req.Message, tt.message)
+
+			payload, _ := json.Marshal(req.Payload)
+			if tt.payload != "" {
+				assert.JSONEq(t, tt.payload, string(payload))
+			}
+
+			// The payload decodes back to its type
+			decoded, err := DecodePayload(req.Type, payload)
+			assert.Nil(t, err)
+			assert.Equal(t, decoded, req.Payload)
+		})
+	}
+}
+
+func TestMessageBuildersValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func() (*PostCommentReq, error)
+	}{
+		{"empty text", func() (*PostCommentReq, error) { return NewTextMessage("bot", roomID, " ") }},
+		{"no buttons", func() (*PostCommentReq, error) { return NewButtonsMessage("bot", roomID, "Choose") }},
+		{"link without url", func() (*PostCommentReq, error) {
+			return NewButtonsMessage("bot", roomID, "Choose", Button{Label: "Open", Type: ButtonTypeLink})
+		}},
+		{"card without title", func() (*PostCommentReq, error) { return NewCardMessage("bot", roomID, Card{}) }},
+		{"relative file url", func() (*PostCommentReq, error) {
+			return NewFileAttachmentMessage("bot", roomID, FileAttachmentPayload{URL: "invoice.pdf"})
+		}},
+		{"unknown contact type", func() (*PostCommentReq, error) {
+			return NewContactPersonMessage("bot", roomID, ContactPersonPayload{Name: "CS", Value: "123", Type: "fax"})
+		}},
+		{"missing room", func() (*PostCommentReq, error) { return NewTextMessage("bot", "", "hello") }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := tt.build()
+			assert.Nil(t, req)
+			assert.True(t, errors.Is(err, ErrInvalidMessage))
+		})
+	}
+}
+
+func TestCommentPayload(t *testing.T) {
+	body := `{"comments":[
+		{"id":1,"type":"text","message":"hello","payload":{}},
+		{"id":2,"type":"buttons","message":"Choose","payload":{"text":"Choose","buttons":[{"label":"Yes","type":"postback","postback_text":"yes"}]}},
+		{"id":3,"type":"file_attachment","message":"[file] https://example.com/a.png [/file]","payload":{"url":"https://example.com/a.png","file_name":"a.png","size":1024}},
+		{"id":4,"type":"system_event","message":"Admin created room","payload":{"type":"create_room","subject_email":"admin@mail.com","room_name":"Room"}},
+		{"id":5,"type":"custom","message":"promo","payload":{"type":"promo_banner","content":{"code":"SALE"}}},
+		{"id":6,"type":"poll","message":"vote","payload":{"options":["a","b"]}},
+		{"id":7,"type":"location","message":"here","payload":{"latitude":"not a number"}}
+	]}`
+
+	var resp struct {
+		Comments []Comment `json:"comments"`
+	}
+	assert.Nil(t, json.Unmarshal([]byte(body), &resp))
+	comments := resp.Comments
+
+	assert.Nil(t, comments[0].Payload)
+	assert.Equal(t, comments[1].Payload.(*ButtonsPayload).Buttons[0].Label, "Yes")
+	assert.Equal(t, comments[2].Payload.(*FileAttachmentPayload).Size, int64(1024))
+	assert.Equal(t, comments[3].Payload.(*SystemEventPayload).SubjectEmail, "admin@mail.com")
+	assert.Equal(t, comments[4].Payload.(*CustomPayload).Type, "promo_banner")
+
+	// Unknown and malformed payloads keep the raw JSON
+	assert.JSONEq(t, string(comments[5].Payload.(json.RawMessage)), `{"options":["a","b"]}`)
+	assert.JSONEq(t, string(comments[6].Payload.(json.RawMessage)), `{"latitude":"not a number"}`)
+
+	// The comment encodes back with its payload
+	encoded, err := json.Marshal(comments[1])
+	assert.Nil(t, err)
+	var decoded Comment
+	assert.Nil(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, decoded.Payload, comments[1].Payload)
+}
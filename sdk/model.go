@@ -0,0 +1,182 @@
+package sdk
+
+import (
+	"encoding/json"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+)
+
+// User is Represent a user
+type User struct {
+	Active    bool                   `json:"active"`
+	AvatarURL string                 `json:"avatar_url"`
+	Extras    map[string]interface{} `json:"extras,omitempty"`
+	UserID    string                 `json:"user_id"`
+	Username  string                 `json:"username"`
+}
+
+// Participant is Represent a room participant
+type Participant = User
+
+// Room is Represent a room
+type Room struct {
+	RoomAvatarURL string `json:"room_avatar_url"`
+	RoomChannelID string `json:"room_channel_id"`
+	RoomID        string `json:"room_id"`
+	RoomName      string `json:"room_name"`
+	RoomOptions   string `json:"room_options"`
+	RoomType      string `json:"room_type"`
+}
+
+// Comment is Represent a comment in a room.
+// Payload is decoded according to Type, e.g. *ButtonsPayload for buttons comment, see DecodePayload.
+type Comment struct {
+	Extras     map[string]interface{} `json:"extras,omitempty"`
+	ID         int                    `json:"id"`
+	Deleted    bool                   `json:"is_deleted,omitempty"`
+	Message    string                 `json:"message"`
+	Timestamp  qiscus.Time            `json:"timestamp"`
+	Type       string                 `json:"type"`
+	UniqueID   string                 `json:"unique_id,omitempty"`
+	User       User                   `json:"user"`
+	Payload    interface{}            `json:"-"`
+	RawPayload json.RawMessage        `json:"payload,omitempty"`
+}
+
+// User returns the user of Get users response as a User
+func (u UserListItem) User() User {
+	return User{
+		Active:    u.Active,
+		AvatarURL: u.AvatarURL,
+		Extras:    u.Extras,
+		UserID:    u.Email,
+		Username:  u.Username,
+	}
+}
+
+// User returns the logged in or registered user
+func (r *LoginOrRegisterResponse) User() User {
+	return r.Results.User
+}
+
+// User returns the user profile
+func (r *GetUserProfileResponse) User() User {
+	return r.Results.User
+}
+
+// Room returns the created room
+func (r *CreateRoomResponse) Room() Room {
+	return r.Results.Room
+}
+
+// Rooms returns the rooms info
+func (r *GetRoomsInfoResponse) Rooms() []Room {
+	return r.Results.Rooms
+}
+
+// Room returns the updated room
+func (r *UpdateRoomResponse) Room() Room {
+	return r.Results.Room
+}
+
+// Participants returns the participants of the page
+func (r *GetRoomParticipantsResponse) Participants() []Participant {
+	return r.Results.Participants
+}
+
+// Participants returns the added participants
+func (r *AddRoomParticipantsResponse) Participants() []Participant {
+	return r.Results.ParticipantsAdded
+}
+
+// Participants returns the removed participants
+func (r *RemoveRoomParticipantsResponse) Participants() []Participant {
+	return r.Results.ParticipantsRemoved
+}
+
+// Rooms returns the rooms of the page
+func (r *GetUserRoomsResponse) Rooms() []Room {
+	return r.Results.Rooms
+}
+
+// Comment returns the posted comment
+func (r *PostCommentResponse) Comment() Comment {
+	return r.Results.Comment
+}
+
+// Comments returns the comments of the page, newest first
+func (r *LoadCommentsResponse) Comments() []Comment {
+	return r.Results.Comments
+}
+
+// Comment returns the posted system event comment
+func (r *PostSystemEventMessageResponse) Comment() Comment {
+	return r.Results.Comment
+}
+
+// Users returns the users of the page
+func (r *GetUsersResponse) Users() []User {
+	users := make([]User, 0, len(r.Results.Users))
+	for _, u := range r.Results.Users {
+		users = append(users, u.User())
+	}
+	return users
+}
+
+// Comments returns the comments in range
+func (r *LoadCommentsWithRangeResponse) Comments() []Comment {
+	return r.Results.Comments
+}
+
+// Room returns the channel room
+func (r *GetOrCreateChannelResponse) Room() Room {
+	return r.Results.Room
+}
+
+// URL returns the hosted URL of the uploaded file
+func (r *UploadResponse) URL() string {
+	return r.Results.File.URL
+}
+
+// Comments returns the deleted comments
+func (r *DeleteCommentsResponse) Comments() []Comment {
+	return r.Results.Comments
+}
+
+// Rooms returns the cleared rooms
+func (r *ClearRoomMessagesResponse) Rooms() []Room {
+	return r.Results.Rooms
+}
+
+// Comment returns the updated comment
+func (r *UpdateCommentResponse) Comment() Comment {
+	return r.Results.Comment
+}
+
+// Receipts returns the receipt of every participant of the room, except the sender of the comment
+func (r *GetCommentReceiptsResponse) Receipts() []CommentReceipt {
+	return r.Results.Receipts
+}
+
+// ReadBy returns the participants who read the comment
+func (r *GetCommentReceiptsResponse) ReadBy() []User {
+	return r.usersWithStatus(ReceiptStatusRead)
+}
+
+// DeliveredTo returns the participants who received the comment, read or not
+func (r *GetCommentReceiptsResponse) DeliveredTo() []User {
+	return r.usersWithStatus(ReceiptStatusDelivered, ReceiptStatusRead)
+}
+
+func (r *GetCommentReceiptsResponse) usersWithStatus(statuses ...string) []User {
+	var users []User
+	for _, receipt := range r.Results.Receipts {
+		for _, status := range statuses {
+			if receipt.Status == status {
+				users = append(users, receipt.User)
+				break
+			}
+		}
+	}
+	return users
+}
@@ -0,0 +1,236 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+)
+
+// pageFunc fetches one page, it returns the number of items and whether the page is the last one
+type pageFunc func(ctx context.Context, page int) (n int, last bool, err *qiscus.Error)
+
+// pager walks every page of a page based endpoint
+type pager struct {
+	ctx   context.Context
+	fetch pageFunc
+	page  int
+	n     int
+	idx   int
+	last  bool
+	err   *qiscus.Error
+}
+
+func newPager(ctx context.Context, page int, fetch pageFunc) pager {
+	// Set default page
+	if page <= 0 {
+		page = 1
+	}
+
+	return pager{ctx: ctx, fetch: fetch, page: page, idx: -1}
+}
+
+// next advances to the next item, fetching the next page when the current one is exhausted
+func (p *pager) next() bool {
+	if p.err != nil {
+		return false
+	}
+
+	p.idx++
+	for p.idx >= p.n {
+		if p.last {
+			return false
+		}
+
+		n, last, err := p.fetch(p.ctx, p.page)
+		if err != nil {
+			p.err = err
+			return false
+		}
+
+		p.page++
+		p.n, p.idx, p.last = n, 0, last || n == 0
+	}
+
+	return true
+}
+
+// lastPage reports whether page, holding n items out of limit, is the last page.
+// A partial page is the last one, so is the page reaching total when the endpoint returns it in meta.
+func lastPage(page, n, limit, total int) bool {
+	if n < limit {
+		return true
+	}
+	// Items of the pages before page count as well, the pager may start after the first page
+	return total > 0 && (page-1)*limit+n >= total
+}
+
+// UsersPager iterates over every user returned by GetUsers
+type UsersPager struct {
+	pager
+	items []UserListItem
+}
+
+// Users returns a pager over every user, starting from req.Page
+func Users(ctx context.Context, c SDK, req *GetUsersReq) *UsersPager {
+	p := &UsersPager{}
+	r := *req
+	p.pager = newPager(ctx, r.Page, func(ctx context.Context, page int) (int, bool, *qiscus.Error) {
+		r.Page = page
+		resp, err := c.GetUsersContext(ctx, &r)
+		if err != nil {
+			return 0, false, err
+		}
+
+		// Total page is only trusted when it is returned, otherwise a partial page is the last one
+		p.items = resp.Results.Users
+		if total := resp.Results.Meta.TotalPage; total > 0 {
+			return len(p.items), page >= total, nil
+		}
+		return len(p.items), len(p.items) < r.Limit, nil
+	})
+
+	return p
+}
+
+// Next advances the pager to the next user, it returns false when there is no more user or an error occurred
+func (p *UsersPager) Next() bool { return p.next() }
+
+// Item returns the current user
+func (p *UsersPager) Item() UserListItem { return p.items[p.idx] }
+
+// Err returns the error that stopped the pager, if any
+func (p *UsersPager) Err() *qiscus.Error { return p.err }
+
+// UserRoomsPager iterates over every room returned by GetUserRooms
+type UserRoomsPager struct {
+	pager
+	items []Room
+}
+
+// UserRooms returns a pager over every room of a user, starting from req.Page
+func UserRooms(ctx context.Context, c SDK, req *GetUserRoomsReq) *UserRoomsPager {
+	p := &UserRoomsPager{}
+	r := *req
+	p.pager = newPager(ctx, r.Page, func(ctx context.Context, page int) (int, bool, *qiscus.Error) {
+		r.Page = page
+		resp, err := c.GetUserRoomsContext(ctx, &r)
+		if err != nil {
+			return 0, false, err
+		}
+
+		p.items = resp.Results.Rooms
+		return len(p.items), lastPage(page, len(p.items), r.Limit, resp.Results.Meta.TotalRoom), nil
+	})
+
+	return p
+}
+
+// Next advances the pager to the next room, it returns false when there is no more room or an error occurred
+func (p *UserRoomsPager) Next() bool { return p.next() }
+
+// Item returns the current room
+func (p *UserRoomsPager) Item() Room { return p.items[p.idx] }
+
+// Err returns the error that stopped the pager, if any
+func (p *UserRoomsPager) Err() *qiscus.Error { return p.err }
+
+// RoomParticipantsPager iterates over every participant returned by GetRoomParticipants
+type RoomParticipantsPager struct {
+	pager
+	items []Participant
+}
+
+// RoomParticipants returns a pager over every participant of a room, starting from req.Page
+func RoomParticipants(ctx context.Context, c SDK, req *GetRoomParticipantsReq) *RoomParticipantsPager {
+	p := &RoomParticipantsPager{}
+	r := *req
+	p.pager = newPager(ctx, r.Page, func(ctx context.Context, page int) (int, bool, *qiscus.Error) {
+		r.Page = page
+		resp, err := c.GetRoomParticipantsContext(ctx, &r)
+		if err != nil {
+			return 0, false, err
+		}
+
+		p.items = resp.Results.Participants
+		return len(p.items), lastPage(page, len(p.items), r.Limit, resp.Results.Meta.Total), nil
+	})
+
+	return p
+}
+
+// Next advances the pager to the next participant, it returns false when there is no more participant or an error occurred
+func (p *RoomParticipantsPager) Next() bool { return p.next() }
+
+// Item returns the current participant
+func (p *RoomParticipantsPager) Item() Participant { return p.items[p.idx] }
+
+// Err returns the error that stopped the pager, if any
+func (p *RoomParticipantsPager) Err() *qiscus.Error { return p.err }
+
+// CommentsPager iterates over every comment returned by LoadComments
+type CommentsPager struct {
+	pager
+	items []Comment
+}
+
+// Comments returns a pager over every comment of a room, starting from req.Page
+func Comments(ctx context.Context, c SDK, req *LoadCommentsReq) *CommentsPager {
+	p := &CommentsPager{}
+	r := *req
+	p.pager = newPager(ctx, r.Page, func(ctx context.Context, page int) (int, bool, *qiscus.Error) {
+		r.Page = page
+		resp, err := c.LoadCommentsContext(ctx, &r)
+		if err != nil {
+			return 0, false, err
+		}
+
+		// Load comments does not return meta, a partial page is the last one
+		p.items = resp.Results.Comments
+		return len(p.items), lastPage(page, len(p.items), r.Limit, 0), nil
+	})
+
+	return p
+}
+
+// Next advances the pager to the next comment, it returns false when there is no more comment or an error occurred
+func (p *CommentsPager) Next() bool { return p.next() }
+
+// Item returns the current comment
+func (p *CommentsPager) Item() Comment { return p.items[p.idx] }
+
+// Err returns the error that stopped the pager, if any
+func (p *CommentsPager) Err() *qiscus.Error { return p.err }
+
+// WebhookLogsPager iterates over every webhook log returned by GetWebhookLogs
+type WebhookLogsPager struct {
+	pager
+	items []WebhookLog
+}
+
+// WebhookLogs returns a pager over every webhook log, starting from req.Page
+func WebhookLogs(ctx context.Context, c SDK, req *GetWebhookLogsReq) *WebhookLogsPager {
+	p := &WebhookLogsPager{}
+	r := *req
+	p.pager = newPager(ctx, r.Page, func(ctx context.Context, page int) (int, bool, *qiscus.Error) {
+		r.Page = page
+		resp, err := c.GetWebhookLogsContext(ctx, &r)
+		if err != nil {
+			return 0, false, err
+		}
+
+		// Webhook logs does not return meta, a partial page is the last one
+		p.items = resp.Results.WebhookLogs
+		return len(p.items), lastPage(page, len(p.items), r.Limit, 0), nil
+	})
+
+	return p
+}
+
+// Next advances the pager to the next webhook log, it returns false when there is no more log or an error occurred
+func (p *WebhookLogsPager) Next() bool { return p.next() }
+
+// Item returns the current webhook log
+func (p *WebhookLogsPager) Item() WebhookLog { return p.items[p.idx] }
+
+// Err returns the error that stopped the pager, if any
+func (p *WebhookLogsPager) Err() *qiscus.Error { return p.err }
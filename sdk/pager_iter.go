@@ -0,0 +1,79 @@
+//go:build go1.23
+
+package sdk
+
+import (
+	"iter"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+)
+
+// All returns an iterator over every user, the iteration stops after yielding an error
+func (p *UsersPager) All() iter.Seq2[UserListItem, *qiscus.Error] {
+	return func(yield func(UserListItem, *qiscus.Error) bool) {
+		for p.Next() {
+			if !yield(p.Item(), nil) {
+				return
+			}
+		}
+		if p.Err() != nil {
+			yield(UserListItem{}, p.Err())
+		}
+	}
+}
+
+// All returns an iterator over every room, the iteration stops after yielding an error
+func (p *UserRoomsPager) All() iter.Seq2[Room, *qiscus.Error] {
+	return func(yield func(Room, *qiscus.Error) bool) {
+		for p.Next() {
+			if !yield(p.Item(), nil) {
+				return
+			}
+		}
+		if p.Err() != nil {
+			yield(Room{}, p.Err())
+		}
+	}
+}
+
+// All returns an iterator over every participant, the iteration stops after yielding an error
+func (p *RoomParticipantsPager) All() iter.Seq2[Participant, *qiscus.Error] {
+	return func(yield func(Participant, *qiscus.Error) bool) {
+		for p.Next() {
+			if !yield(p.Item(), nil) {
+				return
+			}
+		}
+		if p.Err() != nil {
+			yield(Participant{}, p.Err())
+		}
+	}
+}
+
+// All returns an iterator over every comment, the iteration stops after yielding an error
+func (p *CommentsPager) All() iter.Seq2[Comment, *qiscus.Error] {
+	return func(yield func(Comment, *qiscus.Error) bool) {
+		for p.Next() {
+			if !yield(p.Item(), nil) {
+				return
+			}
+		}
+		if p.Err() != nil {
+			yield(Comment{}, p.Err())
+		}
+	}
+}
+
+// All returns an iterator over every webhook log, the iteration stops after yielding an error
+func (p *WebhookLogsPager) All() iter.Seq2[WebhookLog, *qiscus.Error] {
+	return func(yield func(WebhookLog, *qiscus.Error) bool) {
+		for p.Next() {
+			if !yield(p.Item(), nil) {
+				return
+			}
+		}
+		if p.Err() != nil {
+			yield(WebhookLog{}, p.Err())
+		}
+	}
+}
@@ -0,0 +1,33 @@
+//go:build go1.23
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserRoomsAll(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		page := req.URL.Query().Get("page")
+		rsp := fmt.Sprintf(`{"results":{"meta":{"current_page":%s,"total_room":2},"rooms":[{"room_id":"room-%s"}]}}`, page, page)
+		fmt.Fprint(w, rsp)
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	var roomIDs []string
+	for room, err := range UserRooms(context.Background(), c, &GetUserRoomsReq{UserID: "guest@mail.com", Limit: 1}).All() {
+		assert.Nil(t, err)
+		roomIDs = append(roomIDs, room.RoomID)
+	}
+
+	assert.Equal(t, roomIDs, []string{"room-1", "room-2"})
+}
@@ -0,0 +1,174 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsers(t *testing.T) {
+	var requestedPages []int
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.URL.Path, "/api/v2.1/rest/get_user_list")
+
+		page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+		requestedPages = append(requestedPages, page)
+
+		rsp := fmt.Sprintf(`{"results":{"meta":{"total_data":3,"total_page":3},"users":[{"id":%d,"username":"user-%d"}]}}`, page, page)
+		fmt.Fprint(w, rsp)
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	var usernames []string
+	p := Users(context.Background(), c, &GetUsersReq{Limit: 1})
+	for p.Next() {
+		usernames = append(usernames, p.Item().Username)
+	}
+
+	assert.Nil(t, p.Err())
+	assert.Equal(t, usernames, []string{"user-1", "user-2", "user-3"})
+	assert.Equal(t, requestedPages, []int{1, 2, 3})
+}
+
+func TestUsersWithoutMeta(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{"results":{"users":[{"username":"a"},{"username":"b"}]}}`)
+		case "2":
+			fmt.Fprint(w, `{"results":{"meta":{"total_page":0},"users":[{"username":"c"},{"username":"d"}]}}`)
+		case "3":
+			fmt.Fprint(w, `{"results":{"users":[{"username":"e"}]}}`)
+		default:
+			t.Error("pager must stop after a partial page")
+		}
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	var usernames []string
+	p := Users(context.Background(), c, &GetUsersReq{Limit: 2})
+	for p.Next() {
+		usernames = append(usernames, p.Item().Username)
+	}
+
+	assert.Nil(t, p.Err())
+	assert.Equal(t, usernames, []string{"a", "b", "c", "d", "e"})
+}
+
+func TestRoomParticipants(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.URL.Query().Get("room_id"), roomID)
+		assert.Equal(t, req.URL.Query().Get("limit"), "2")
+
+		switch req.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{"results":{"participants":[{"user_id":"a"},{"user_id":"b"}]}}`)
+		case "2":
+			fmt.Fprint(w, `{"results":{"participants":[{"user_id":"c"}]}}`)
+		default:
+			t.Error("pager must stop after a partial page")
+		}
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	var userIDs []string
+	p := RoomParticipants(context.Background(), c, &GetRoomParticipantsReq{RoomID: roomID, Limit: 2})
+	for p.Next() {
+		userIDs = append(userIDs, p.Item().UserID)
+	}
+
+	assert.Nil(t, p.Err())
+	assert.Equal(t, userIDs, []string{"a", "b", "c"})
+}
+
+func TestRoomParticipantsFromPage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Query().Get("page") {
+		case "2":
+			fmt.Fprint(w, `{"results":{"meta":{"total":6},"participants":[{"user_id":"c"},{"user_id":"d"}]}}`)
+		case "3":
+			fmt.Fprint(w, `{"results":{"meta":{"total":6},"participants":[{"user_id":"e"},{"user_id":"f"}]}}`)
+		default:
+			t.Error("pager must stop after the page reaching total")
+		}
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	var userIDs []string
+	p := RoomParticipants(context.Background(), c, &GetRoomParticipantsReq{RoomID: roomID, Page: 2, Limit: 2})
+	for p.Next() {
+		userIDs = append(userIDs, p.Item().UserID)
+	}
+
+	assert.Nil(t, p.Err())
+	assert.Equal(t, userIDs, []string{"c", "d", "e", "f"})
+}
+
+func TestUserRoomsFromPage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Query().Get("page") {
+		case "3":
+			fmt.Fprint(w, `{"results":{"meta":{"total_room":6},"rooms":[{"room_id":"5"},{"room_id":"6"}]}}`)
+		default:
+			t.Error("pager must stop after the page reaching total")
+		}
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	var roomIDs []string
+	p := UserRooms(context.Background(), c, &GetUserRoomsReq{UserID: "guest@qiscus.com", Page: 3, Limit: 2})
+	for p.Next() {
+		roomIDs = append(roomIDs, p.Item().RoomID)
+	}
+
+	assert.Nil(t, p.Err())
+	assert.Equal(t, roomIDs, []string{"5", "6"})
+}
+
+func TestCommentsError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Query().Get("page") == "1" {
+			fmt.Fprint(w, `{"results":{"comments":[{"id":1},{"id":2}]}}`)
+			return
+		}
+
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{"error":{"message":"internal error"}}`)
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	var ids []int
+	p := Comments(context.Background(), c, &LoadCommentsReq{RoomID: roomID, Limit: 2})
+	for p.Next() {
+		ids = append(ids, p.Item().ID)
+	}
+
+	assert.Equal(t, ids, []int{1, 2})
+	assert.NotNil(t, p.Err())
+	assert.Equal(t, p.Err().GetStatusCode(), http.StatusInternalServerError)
+	assert.False(t, p.Next())
+}
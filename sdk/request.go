@@ -1,5 +1,7 @@
 package sdk
 
+import "time"
+
 // LoginOrRegisterReq is Represent Login or register request payload
 type LoginOrRegisterReq struct {
 	UserID    string `json:"user_id"`
@@ -116,18 +118,18 @@ type GetOrCreateChannelReq struct {
 	RoomOptions   string   `json:"room_options"`
 }
 
-// GetUserResponseRateReq is Represent Get average reply time user request payload
+// GetUserResponseRateReq is Represent Get user response rate request payload
 type GetUserResponseRateReq struct {
 	UserIDs   []string
-	StartTime string // in format "YYYY-MM-DD hh:mm:ss"
-	EndTime   string // in format "YYYY-MM-DD hh:mm:ss"
+	StartTime time.Time
+	EndTime   time.Time
 }
 
 // GetAverageReplyTimeUserReq is Represent Get average reply time user request payload
 type GetAverageReplyTimeUserReq struct {
 	UserID    string
-	StartTime string // in format "YYYY-MM-DD hh:mm:ss"
-	EndTime   string // in format "YYYY-MM-DD hh:mm:ss"
+	StartTime time.Time
+	EndTime   time.Time
 }
 
 // GetWebhookLogsReq is Represent Get webhook logs request payload
@@ -146,3 +148,43 @@ type DeactivateUserReq struct {
 type ReactivateUserReq struct {
 	UserIDs []string `json:"user_ids"`
 }
+
+// DeleteCommentsReq is Represent Delete comments request payload.
+// The comments are identified by CommentIDs, UniqueIDs or both, they must be sent by UserID.
+type DeleteCommentsReq struct {
+	UserID      string   `json:"user_id"`
+	CommentIDs  []int    `json:"comment_ids,omitempty"`
+	UniqueIDs   []string `json:"unique_ids,omitempty"`
+	ForEveryone bool     `json:"is_delete_for_everyone"` // false deletes the comments for the sender only
+	HardDelete  bool     `json:"is_hard_delete"`         // removes the comments instead of marking them deleted, requires ForEveryone
+}
+
+// ClearRoomMessagesReq is Represent Clear room messages request payload
+type ClearRoomMessagesReq struct {
+	RoomIDs []string `json:"room_ids"`
+}
+
+// UpdateCommentReq is Represent Update comment request payload.
+// The comment is identified by CommentID or UniqueID, it must be sent by UserID.
+type UpdateCommentReq struct {
+	UserID    string      `json:"user_id"`
+	CommentID int         `json:"comment_id,omitempty"`
+	UniqueID  string      `json:"unique_id,omitempty"`
+	Message   string      `json:"message"`
+	Payload   interface{} `json:"payload,omitempty"`
+	Extras    interface{} `json:"extras,omitempty"`
+}
+
+// MarkCommentsReq is Represent Mark comments as read or delivered request payload.
+// Every comment of the room up to CommentID is marked for UserID.
+type MarkCommentsReq struct {
+	UserID    string
+	RoomID    string
+	CommentID int
+}
+
+// GetCommentReceiptsReq is Represent Get comment receipts request payload
+type GetCommentReceiptsReq struct {
+	RoomID    string
+	CommentID int
+}
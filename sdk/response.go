@@ -1,18 +1,11 @@
 package sdk
 
-import "time"
+import "github.com/Qiscus-Integration/qiscus-go"
 
 // LoginOrRegisterResponse is Represent Login or register response payload
 type LoginOrRegisterResponse struct {
 	Results struct {
-		User struct {
-			Active    bool   `json:"active"`
-			AvatarURL string `json:"avatar_url"`
-			Extras    struct {
-			} `json:"extras"`
-			UserID   string `json:"user_id"`
-			Username string `json:"username"`
-		} `json:"user"`
+		User User `json:"user"`
 	} `json:"results"`
 	Status int `json:"status"`
 }
@@ -20,14 +13,7 @@ type LoginOrRegisterResponse struct {
 // GetUserProfileResponse is Represent Get user profile response payload
 type GetUserProfileResponse struct {
 	Results struct {
-		User struct {
-			Active    bool   `json:"active"`
-			AvatarURL string `json:"avatar_url"`
-			Extras    struct {
-			} `json:"extras"`
-			UserID   string `json:"user_id"`
-			Username string `json:"username"`
-		} `json:"user"`
+		User User `json:"user"`
 	} `json:"results"`
 	Status int `json:"status"`
 }
@@ -43,14 +29,7 @@ type GetUserTokenResponse struct {
 // CreateRoomResponse is Represent Create room response payload
 type CreateRoomResponse struct {
 	Results struct {
-		Room struct {
-			RoomAvatarURL string `json:"room_avatar_url"`
-			RoomChannelID string `json:"room_channel_id"`
-			RoomID        string `json:"room_id"`
-			RoomName      string `json:"room_name"`
-			RoomOptions   string `json:"room_options"`
-			RoomType      string `json:"room_type"`
-		} `json:"room"`
+		Room Room `json:"room"`
 	} `json:"results"`
 	Status int `json:"status"`
 }
@@ -58,14 +37,7 @@ type CreateRoomResponse struct {
 // GetRoomsInfoResponse is Represent Get rooms info response payload
 type GetRoomsInfoResponse struct {
 	Results struct {
-		Rooms []struct {
-			RoomAvatarURL string `json:"room_avatar_url"`
-			RoomChannelID string `json:"room_channel_id"`
-			RoomID        string `json:"room_id"`
-			RoomName      string `json:"room_name"`
-			RoomOptions   string `json:"room_options"`
-			RoomType      string `json:"room_type"`
-		} `json:"rooms"`
+		Rooms []Room `json:"rooms"`
 	} `json:"results"`
 	Status int `json:"status"`
 }
@@ -74,14 +46,7 @@ type GetRoomsInfoResponse struct {
 type UpdateRoomResponse struct {
 	Results struct {
 		Changed bool `json:"changed"`
-		Room    struct {
-			RoomAvatarURL string `json:"room_avatar_url"`
-			RoomChannelID string `json:"room_channel_id"`
-			RoomID        string `json:"room_id"`
-			RoomName      string `json:"room_name"`
-			RoomOptions   string `json:"room_options"`
-			RoomType      string `json:"room_type"`
-		} `json:"room"`
+		Room    Room `json:"room"`
 	} `json:"results"`
 	Status int `json:"status"`
 }
@@ -89,14 +54,12 @@ type UpdateRoomResponse struct {
 // GetRoomParticipantsResponse is Represent Get room participants response payload
 type GetRoomParticipantsResponse struct {
 	Results struct {
-		Participants []struct {
-			Active    bool   `json:"active"`
-			AvatarURL string `json:"avatar_url"`
-			Extras    struct {
-			} `json:"extras"`
-			UserID   string `json:"user_id"`
-			Username string `json:"username"`
-		} `json:"participants"`
+		Meta struct {
+			CurrentPage int `json:"current_page"`
+			PerPage     int `json:"per_page"`
+			Total       int `json:"total"`
+		} `json:"meta"`
+		Participants []Participant `json:"participants"`
 	} `json:"results"`
 	Status int `json:"status"`
 }
@@ -104,14 +67,7 @@ type GetRoomParticipantsResponse struct {
 // AddRoomParticipantsResponse is Represent Add room participants response payload
 type AddRoomParticipantsResponse struct {
 	Results struct {
-		ParticipantsAdded []struct {
-			Active    bool   `json:"active"`
-			AvatarURL string `json:"avatar_url"`
-			Extras    struct {
-			} `json:"extras"`
-			UserID   string `json:"user_id"`
-			Username string `json:"username"`
-		} `json:"participants_added"`
+		ParticipantsAdded []Participant `json:"participants_added"`
 	} `json:"results"`
 	Status int `json:"status"`
 }
@@ -119,14 +75,7 @@ type AddRoomParticipantsResponse struct {
 // RemoveRoomParticipantsResponse is Represent Remove room participants response payload
 type RemoveRoomParticipantsResponse struct {
 	Results struct {
-		ParticipantsRemoved []struct {
-			Active    bool   `json:"active"`
-			AvatarURL string `json:"avatar_url"`
-			Extras    struct {
-			} `json:"extras"`
-			UserID   string `json:"user_id"`
-			Username string `json:"username"`
-		} `json:"participants_removed"`
+		ParticipantsRemoved []Participant `json:"participants_removed"`
 	} `json:"results"`
 	Status int `json:"status"`
 }
@@ -138,14 +87,7 @@ type GetUserRoomsResponse struct {
 			CurrentPage int `json:"current_page"`
 			TotalRoom   int `json:"total_room"`
 		} `json:"meta"`
-		Rooms []struct {
-			RoomAvatarURL string `json:"room_avatar_url"`
-			RoomChannelID string `json:"room_channel_id"`
-			RoomID        string `json:"room_id"`
-			RoomName      string `json:"room_name"`
-			RoomOptions   string `json:"room_options"`
-			RoomType      string `json:"room_type"`
-		} `json:"rooms"`
+		Rooms []Room `json:"rooms"`
 	} `json:"results"`
 	Status int `json:"status"`
 }
@@ -153,24 +95,7 @@ type GetUserRoomsResponse struct {
 // PostCommentResponse is Represent Post comment response payload
 type PostCommentResponse struct {
 	Results struct {
-		Comment struct {
-			Extras struct {
-			} `json:"extras"`
-			ID      int    `json:"id"`
-			Message string `json:"message"`
-			Payload struct {
-			} `json:"payload"`
-			Timestamp time.Time `json:"timestamp"`
-			Type      string    `json:"type"`
-			User      struct {
-				Active    bool   `json:"active"`
-				AvatarURL string `json:"avatar_url"`
-				Extras    struct {
-				} `json:"extras"`
-				UserID   string `json:"user_id"`
-				Username string `json:"username"`
-			} `json:"user"`
-		} `json:"comment"`
+		Comment Comment `json:"comment"`
 	} `json:"results"`
 	Status int `json:"status"`
 }
@@ -178,38 +103,7 @@ type PostCommentResponse struct {
 // LoadCommentsResponse is Represent Load comments response payload
 type LoadCommentsResponse struct {
 	Results struct {
-		Comments []struct {
-			Extras struct {
-				Action string `json:"action"`
-			} `json:"extras,omitempty"`
-			ID        int       `json:"id"`
-			Message   string    `json:"message"`
-			Timestamp time.Time `json:"timestamp"`
-			Type      string    `json:"type"`
-			User      struct {
-				Active    bool   `json:"active"`
-				AvatarURL string `json:"avatar_url"`
-				Extras    struct {
-					Type            string      `json:"type"`
-					UserBubbleColor interface{} `json:"user_bubble_color"`
-				} `json:"extras"`
-				UserID   string `json:"user_id"`
-				Username string `json:"username"`
-			} `json:"user"`
-			Payload struct {
-				ObjectEmail        string        `json:"object_email"`
-				ObjectEmailList    []interface{} `json:"object_email_list"`
-				ObjectUsername     string        `json:"object_username"`
-				ObjectUsernameList []interface{} `json:"object_username_list"`
-				Payload            struct {
-					Type string `json:"type"`
-				} `json:"payload"`
-				RoomName        string `json:"room_name"`
-				SubjectEmail    string `json:"subject_email"`
-				SubjectUsername string `json:"subject_username"`
-				Type            string `json:"type"`
-			} `json:"payload,omitempty"`
-		} `json:"comments"`
+		Comments []Comment `json:"comments"`
 	} `json:"results"`
 	Status int `json:"status"`
 }
@@ -217,41 +111,7 @@ type LoadCommentsResponse struct {
 // PostSystemEventMessageResponse is Represent Post system event message response payload
 type PostSystemEventMessageResponse struct {
 	Results struct {
-		Comment struct {
-			Extras struct {
-				QiscusIosPn struct {
-					Aps struct {
-						ContentAvaibility int `json:"content-avaibility"`
-					} `json:"aps"`
-				} `json:"qiscus_ios_pn"`
-			} `json:"extras"`
-			ID      int    `json:"id"`
-			Message string `json:"message"`
-			Payload struct {
-				ObjectEmail        string        `json:"object_email"`
-				ObjectEmailList    []interface{} `json:"object_email_list"`
-				ObjectUsername     string        `json:"object_username"`
-				ObjectUsernameList []interface{} `json:"object_username_list"`
-				Payload            struct {
-					AdminEmail string `json:"admin_email"`
-					Type       string `json:"type"`
-				} `json:"payload"`
-				RoomName        string `json:"room_name"`
-				SubjectEmail    string `json:"subject_email"`
-				SubjectUsername string `json:"subject_username"`
-				Type            string `json:"type"`
-			} `json:"payload"`
-			Timestamp time.Time `json:"timestamp"`
-			Type      string    `json:"type"`
-			User      struct {
-				Active    bool   `json:"active"`
-				AvatarURL string `json:"avatar_url"`
-				Extras    struct {
-				} `json:"extras"`
-				UserID   string `json:"user_id"`
-				Username string `json:"username"`
-			} `json:"user"`
-		} `json:"comment"`
+		Comment Comment `json:"comment"`
 	} `json:"results"`
 	Status int `json:"status"`
 }
@@ -267,6 +127,19 @@ type GetUnreadCountResponse struct {
 	Status int `json:"status"`
 }
 
+// UserListItem is Represent a user in Get users response
+type UserListItem struct {
+	Active    bool                   `json:"active"`
+	AvatarURL string                 `json:"avatar_url"`
+	CreatedAt qiscus.Time            `json:"created_at"`
+	Email     string                 `json:"email"`
+	Extras    map[string]interface{} `json:"extras,omitempty"`
+	ID        int                    `json:"id"`
+	Name      string                 `json:"name"`
+	UpdatedAt qiscus.Time            `json:"updated_at"`
+	Username  string                 `json:"username"`
+}
+
 // GetUsersResponse is Represent Get users response payload
 type GetUsersResponse struct {
 	Results struct {
@@ -274,20 +147,7 @@ type GetUsersResponse struct {
 			TotalData int `json:"total_data"`
 			TotalPage int `json:"total_page"`
 		} `json:"meta"`
-		Users []struct {
-			Active    bool      `json:"active"`
-			AvatarURL string    `json:"avatar_url"`
-			CreatedAt time.Time `json:"created_at"`
-			Email     string    `json:"email"`
-			Extras    struct {
-				Type            string      `json:"type"`
-				UserBubbleColor interface{} `json:"user_bubble_color"`
-			} `json:"extras,omitempty"`
-			ID        int       `json:"id"`
-			Name      string    `json:"name"`
-			UpdatedAt time.Time `json:"updated_at"`
-			Username  string    `json:"username"`
-		} `json:"users"`
+		Users []UserListItem `json:"users"`
 	} `json:"results"`
 	Status int `json:"status"`
 }
@@ -295,42 +155,16 @@ type GetUsersResponse struct {
 // LoadCommentsWithRangeResponse is Represent Load comments with range response payload
 type LoadCommentsWithRangeResponse struct {
 	Results struct {
-		Comments []struct {
-			Extras struct {
-				Action string `json:"action"`
-			} `json:"extras,omitempty"`
-			ID        int         `json:"id"`
-			Message   string      `json:"message"`
-			Payload   interface{} `json:"payload"`
-			Timestamp string      `json:"timestamp"`
-			Type      string      `json:"type"`
-			UniqueID  string      `json:"unique_id"`
-			User      struct {
-				Active    bool   `json:"active"`
-				AvatarURL string `json:"avatar_url"`
-				Extras    struct {
-					Type            string      `json:"type"`
-					UserBubbleColor interface{} `json:"user_bubble_color"`
-				} `json:"extras,omitempty"`
-				UserID   string `json:"user_id"`
-				Username string `json:"username"`
-			} `json:"user"`
-		} `json:"comments"`
+		Comments []Comment `json:"comments"`
 	} `json:"results"`
+	Status int `json:"status"`
 }
 
 // GetOrCreateChannelResponse is Represent Get or create channel response payload
 type GetOrCreateChannelResponse struct {
 	Results struct {
 		Changed bool `json:"changed"`
-		Room    struct {
-			RoomAvatarURL string `json:"room_avatar_url"`
-			RoomChannelID string `json:"room_channel_id"`
-			RoomID        string `json:"room_id"`
-			RoomName      string `json:"room_name"`
-			RoomOptions   string `json:"room_options"`
-			RoomType      string `json:"room_type"`
-		} `json:"room"`
+		Room    Room `json:"room"`
 	} `json:"results"`
 	Status int `json:"status"`
 }
@@ -339,32 +173,54 @@ type GetOrCreateChannelResponse struct {
 type GetAverageReplyTimeUserResponse struct {
 	Results struct {
 		Data struct {
-			Duration struct {
-				Average  int `json:"average"`
-				Longest  int `json:"longest"`
-				Shortest int `json:"shortest"`
-			} `json:"duration"`
-			UserID string `json:"user_id"`
+			Duration ReplyDuration `json:"duration"`
+			UserID   string        `json:"user_id"`
 		} `json:"data"`
-		EndTime   string `json:"end_time"`
-		StartTime string `json:"start_time"`
+		EndTime   qiscus.Time `json:"end_time"`
+		StartTime qiscus.Time `json:"start_time"`
 	} `json:"results"`
 	Status int `json:"status"`
 }
 
+// ReplyDuration is Represent reply time of a user, in seconds
+type ReplyDuration struct {
+	Average  int `json:"average"`
+	Longest  int `json:"longest"`
+	Shortest int `json:"shortest"`
+}
+
+// UserResponseRate is Represent reply time of a user in Get user response rate response
+type UserResponseRate struct {
+	Duration ReplyDuration `json:"duration"`
+	UserID   string        `json:"user_id"`
+}
+
+// GetUserResponseRateResponse is Represent Get user response rate response payload
+type GetUserResponseRateResponse struct {
+	Results struct {
+		Data      []UserResponseRate `json:"data"`
+		EndTime   qiscus.Time        `json:"end_time"`
+		StartTime qiscus.Time        `json:"start_time"`
+	} `json:"results"`
+	Status int `json:"status"`
+}
+
+// WebhookLog is Represent a webhook delivery log
+type WebhookLog struct {
+	AttemptedAt  qiscus.Time `json:"attempted_at"`
+	Endpoint     string      `json:"endpoint"`
+	ErrorMessage string      `json:"error_message"`
+	ID           int         `json:"id"`
+	IsSuccess    bool        `json:"is_success"`
+	RequestBody  string      `json:"request_body"`
+	ResponseBody string      `json:"response_body"`
+	ResponseCode int         `json:"response_code"`
+}
+
 // GetWebhookLogsResponse is Represent Get webhook logs response payload
 type GetWebhookLogsResponse struct {
 	Results struct {
-		WebhookLogs []struct {
-			AttemptedAt  time.Time `json:"attempted_at"`
-			Endpoint     string    `json:"endpoint"`
-			ErrorMessage string    `json:"error_message"`
-			ID           int       `json:"id"`
-			IsSuccess    bool      `json:"is_success"`
-			RequestBody  time.Time `json:"request_body"`
-			ResponseBody string    `json:"response_body"`
-			ResponseCode int       `json:"response_code"`
-		} `json:"webhook_logs"`
+		WebhookLogs []WebhookLog `json:"webhook_logs"`
 	} `json:"results"`
 	Status int `json:"status"`
 }
@@ -384,3 +240,71 @@ type ReactivateUserResponse struct {
 	} `json:"results"`
 	Status int `json:"status"`
 }
+
+// UploadResponse is Represent Upload response payload
+type UploadResponse struct {
+	Results struct {
+		File struct {
+			URL string `json:"url"`
+		} `json:"file"`
+	} `json:"results"`
+	Status int `json:"status"`
+}
+
+// DeleteCommentsResponse is Represent Delete comments response payload
+type DeleteCommentsResponse struct {
+	Results struct {
+		Comments []Comment `json:"comments"`
+	} `json:"results"`
+	Status int `json:"status"`
+}
+
+// ClearRoomMessagesResponse is Represent Clear room messages response payload
+type ClearRoomMessagesResponse struct {
+	Results struct {
+		Rooms []Room `json:"rooms"`
+	} `json:"results"`
+	Status int `json:"status"`
+}
+
+// UpdateCommentResponse is Represent Update comment response payload
+type UpdateCommentResponse struct {
+	Results struct {
+		Comment Comment `json:"comment"`
+	} `json:"results"`
+	Status int `json:"status"`
+}
+
+// MarkCommentsResponse is Represent Mark comments as read or delivered response payload
+type MarkCommentsResponse struct {
+	Results struct {
+		UserID                 string `json:"user_id"`
+		RoomID                 string `json:"room_id"`
+		LastReadCommentID      int    `json:"last_comment_read_id"`
+		LastDeliveredCommentID int    `json:"last_comment_received_id"`
+	} `json:"results"`
+	Status int `json:"status"`
+}
+
+// Receipt status of a comment for a participant
+const (
+	ReceiptStatusSent      = "sent"      // the comment is not delivered to the participant yet
+	ReceiptStatusDelivered = "delivered" // the comment is delivered but not read
+	ReceiptStatusRead      = "read"
+)
+
+// CommentReceipt is Represent the receipt of a comment for a participant
+type CommentReceipt struct {
+	User                   User   `json:"user"`
+	Status                 string `json:"status"`
+	LastReadCommentID      int    `json:"last_comment_read_id"`
+	LastDeliveredCommentID int    `json:"last_comment_received_id"`
+}
+
+// GetCommentReceiptsResponse is Represent Get comment receipts response payload
+type GetCommentReceiptsResponse struct {
+	Results struct {
+		Receipts []CommentReceipt `json:"receipts"`
+	} `json:"results"`
+	Status int `json:"status"`
+}
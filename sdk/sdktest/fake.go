@@ -0,0 +1,814 @@
+// Code generated by fakegen. DO NOT EDIT.
+
+package sdktest
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+	"github.com/Qiscus-Integration/qiscus-go/sdk"
+)
+
+// Call is a call recorded by FakeSDK
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// FakeSDK is a fake sdk.SDK for unit tests.
+// Every method records its call and calls the matching Func field when set.
+// A method without Func falls back to its Context variant Func, otherwise it returns empty results.
+type FakeSDK struct {
+	mu    sync.Mutex
+	calls []Call
+
+	APIBaseFunc                          func() string
+	QiscusAppIDFunc                      func() string
+	QiscusSecretKeyFunc                  func() string
+	SetAPIBaseFunc                       func(string)
+	SetRetryPolicyFunc                   func(*qiscus.RetryPolicy)
+	LoginOrRegisterFunc                  func(*sdk.LoginOrRegisterReq) (*sdk.LoginOrRegisterResponse, *qiscus.Error)
+	LoginOrRegisterContextFunc           func(context.Context, *sdk.LoginOrRegisterReq) (*sdk.LoginOrRegisterResponse, *qiscus.Error)
+	GetUserProfileFunc                   func(string) (*sdk.GetUserProfileResponse, *qiscus.Error)
+	GetUserProfileContextFunc            func(context.Context, string) (*sdk.GetUserProfileResponse, *qiscus.Error)
+	GetUserTokenFunc                     func(string) (*sdk.GetUserTokenResponse, *qiscus.Error)
+	GetUserTokenContextFunc              func(context.Context, string) (*sdk.GetUserTokenResponse, *qiscus.Error)
+	ResetUserTokenFunc                   func(string) (*sdk.GetUserTokenResponse, *qiscus.Error)
+	ResetUserTokenContextFunc            func(context.Context, string) (*sdk.GetUserTokenResponse, *qiscus.Error)
+	CreateRoomFunc                       func(*sdk.CreateRoomReq) (*sdk.CreateRoomResponse, *qiscus.Error)
+	CreateRoomContextFunc                func(context.Context, *sdk.CreateRoomReq) (*sdk.CreateRoomResponse, *qiscus.Error)
+	GetOrCreateRoomWithTargetFunc        func(*sdk.GetOrCreateRoomWithTargetReq) (*sdk.CreateRoomResponse, *qiscus.Error)
+	GetOrCreateRoomWithTargetContextFunc func(context.Context, *sdk.GetOrCreateRoomWithTargetReq) (*sdk.CreateRoomResponse, *qiscus.Error)
+	GetRoomsInfoFunc                     func([]string) (*sdk.GetRoomsInfoResponse, *qiscus.Error)
+	GetRoomsInfoContextFunc              func(context.Context, []string) (*sdk.GetRoomsInfoResponse, *qiscus.Error)
+	UpdateRoomFunc                       func(*sdk.UpdateRoomReq) (*sdk.UpdateRoomResponse, *qiscus.Error)
+	UpdateRoomContextFunc                func(context.Context, *sdk.UpdateRoomReq) (*sdk.UpdateRoomResponse, *qiscus.Error)
+	GetRoomParticipantsFunc              func(*sdk.GetRoomParticipantsReq) (*sdk.GetRoomParticipantsResponse, *qiscus.Error)
+	GetRoomParticipantsContextFunc       func(context.Context, *sdk.GetRoomParticipantsReq) (*sdk.GetRoomParticipantsResponse, *qiscus.Error)
+	AddRoomParticipantsFunc              func(*sdk.AddRoomParticipantsReq) (*sdk.AddRoomParticipantsResponse, *qiscus.Error)
+	AddRoomParticipantsContextFunc       func(context.Context, *sdk.AddRoomParticipantsReq) (*sdk.AddRoomParticipantsResponse, *qiscus.Error)
+	RemoveRoomParticipantsFunc           func(*sdk.RemoveRoomParticipantsReq) (*sdk.RemoveRoomParticipantsResponse, *qiscus.Error)
+	RemoveRoomParticipantsContextFunc    func(context.Context, *sdk.RemoveRoomParticipantsReq) (*sdk.RemoveRoomParticipantsResponse, *qiscus.Error)
+	GetUserRoomsFunc                     func(*sdk.GetUserRoomsReq) (*sdk.GetUserRoomsResponse, *qiscus.Error)
+	GetUserRoomsContextFunc              func(context.Context, *sdk.GetUserRoomsReq) (*sdk.GetUserRoomsResponse, *qiscus.Error)
+	PostCommentFunc                      func(*sdk.PostCommentReq) (*sdk.PostCommentResponse, *qiscus.Error)
+	PostCommentContextFunc               func(context.Context, *sdk.PostCommentReq) (*sdk.PostCommentResponse, *qiscus.Error)
+	LoadCommentsFunc                     func(*sdk.LoadCommentsReq) (*sdk.LoadCommentsResponse, *qiscus.Error)
+	LoadCommentsContextFunc              func(context.Context, *sdk.LoadCommentsReq) (*sdk.LoadCommentsResponse, *qiscus.Error)
+	PostSystemEventMessageFunc           func(*sdk.PostSystemEventMessageReq) (*sdk.PostSystemEventMessageResponse, *qiscus.Error)
+	PostSystemEventMessageContextFunc    func(context.Context, *sdk.PostSystemEventMessageReq) (*sdk.PostSystemEventMessageResponse, *qiscus.Error)
+	GetUnreadCountFunc                   func(*sdk.GetUnreadCountReq) (*sdk.GetUnreadCountResponse, *qiscus.Error)
+	GetUnreadCountContextFunc            func(context.Context, *sdk.GetUnreadCountReq) (*sdk.GetUnreadCountResponse, *qiscus.Error)
+	GetUsersFunc                         func(*sdk.GetUsersReq) (*sdk.GetUsersResponse, *qiscus.Error)
+	GetUsersContextFunc                  func(context.Context, *sdk.GetUsersReq) (*sdk.GetUsersResponse, *qiscus.Error)
+	LoadCommentsWithRangeFunc            func(*sdk.LoadCommentsWithRangeReq) (*sdk.LoadCommentsWithRangeResponse, *qiscus.Error)
+	LoadCommentsWithRangeContextFunc     func(context.Context, *sdk.LoadCommentsWithRangeReq) (*sdk.LoadCommentsWithRangeResponse, *qiscus.Error)
+	GetOrCreateChannelFunc               func(*sdk.GetOrCreateChannelReq) (*sdk.GetOrCreateChannelResponse, *qiscus.Error)
+	GetOrCreateChannelContextFunc        func(context.Context, *sdk.GetOrCreateChannelReq) (*sdk.GetOrCreateChannelResponse, *qiscus.Error)
+	GetAverageReplyTimeUserFunc          func(*sdk.GetAverageReplyTimeUserReq) (*sdk.GetAverageReplyTimeUserResponse, *qiscus.Error)
+	GetAverageReplyTimeUserContextFunc   func(context.Context, *sdk.GetAverageReplyTimeUserReq) (*sdk.GetAverageReplyTimeUserResponse, *qiscus.Error)
+	GetUserResponseRateFunc              func(*sdk.GetUserResponseRateReq) (*sdk.GetUserResponseRateResponse, *qiscus.Error)
+	GetUserResponseRateContextFunc       func(context.Context, *sdk.GetUserResponseRateReq) (*sdk.GetUserResponseRateResponse, *qiscus.Error)
+	GetWebhookLogsFunc                   func(*sdk.GetWebhookLogsReq) (*sdk.GetWebhookLogsResponse, *qiscus.Error)
+	GetWebhookLogsContextFunc            func(context.Context, *sdk.GetWebhookLogsReq) (*sdk.GetWebhookLogsResponse, *qiscus.Error)
+	DeactivateUserFunc                   func(*sdk.DeactivateUserReq) (*sdk.DeactivateUserResponse, *qiscus.Error)
+	DeactivateUserContextFunc            func(context.Context, *sdk.DeactivateUserReq) (*sdk.DeactivateUserResponse, *qiscus.Error)
+	ReactivateUserFunc                   func(*sdk.ReactivateUserReq) (*sdk.ReactivateUserResponse, *qiscus.Error)
+	ReactivateUserContextFunc            func(context.Context, *sdk.ReactivateUserReq) (*sdk.ReactivateUserResponse, *qiscus.Error)
+	DeleteCommentsFunc                   func(*sdk.DeleteCommentsReq) (*sdk.DeleteCommentsResponse, *qiscus.Error)
+	DeleteCommentsContextFunc            func(context.Context, *sdk.DeleteCommentsReq) (*sdk.DeleteCommentsResponse, *qiscus.Error)
+	ClearRoomMessagesFunc                func(*sdk.ClearRoomMessagesReq) (*sdk.ClearRoomMessagesResponse, *qiscus.Error)
+	ClearRoomMessagesContextFunc         func(context.Context, *sdk.ClearRoomMessagesReq) (*sdk.ClearRoomMessagesResponse, *qiscus.Error)
+	UpdateCommentFunc                    func(*sdk.UpdateCommentReq) (*sdk.UpdateCommentResponse, *qiscus.Error)
+	UpdateCommentContextFunc             func(context.Context, *sdk.UpdateCommentReq) (*sdk.UpdateCommentResponse, *qiscus.Error)
+	MarkCommentsReadFunc                 func(*sdk.MarkCommentsReq) (*sdk.MarkCommentsResponse, *qiscus.Error)
+	MarkCommentsReadContextFunc          func(context.Context, *sdk.MarkCommentsReq) (*sdk.MarkCommentsResponse, *qiscus.Error)
+	MarkCommentsDeliveredFunc            func(*sdk.MarkCommentsReq) (*sdk.MarkCommentsResponse, *qiscus.Error)
+	MarkCommentsDeliveredContextFunc     func(context.Context, *sdk.MarkCommentsReq) (*sdk.MarkCommentsResponse, *qiscus.Error)
+	GetCommentReceiptsFunc               func(*sdk.GetCommentReceiptsReq) (*sdk.GetCommentReceiptsResponse, *qiscus.Error)
+	GetCommentReceiptsContextFunc        func(context.Context, *sdk.GetCommentReceiptsReq) (*sdk.GetCommentReceiptsResponse, *qiscus.Error)
+	UploadFunc                           func(context.Context, string, io.Reader, ...sdk.UploadOption) (*sdk.UploadResponse, *qiscus.Error)
+}
+
+var _ sdk.SDK = (*FakeSDK)(nil)
+
+// Calls returns the recorded calls in order
+func (f *FakeSDK) Calls() []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]Call(nil), f.calls...)
+}
+
+// CallsTo returns the recorded calls of a method in order
+func (f *FakeSDK) CallsTo(method string) []Call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var calls []Call
+	for _, c := range f.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// Reset clears the recorded calls
+func (f *FakeSDK) Reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = nil
+}
+
+func (f *FakeSDK) record(method string, args ...interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = append(f.calls, Call{Method: method, Args: args})
+}
+
+// APIBase calls APIBaseFunc
+func (f *FakeSDK) APIBase() string {
+	f.record("APIBase")
+	if f.APIBaseFunc != nil {
+		return f.APIBaseFunc()
+	}
+	return ""
+}
+
+// QiscusAppID calls QiscusAppIDFunc
+func (f *FakeSDK) QiscusAppID() string {
+	f.record("QiscusAppID")
+	if f.QiscusAppIDFunc != nil {
+		return f.QiscusAppIDFunc()
+	}
+	return ""
+}
+
+// QiscusSecretKey calls QiscusSecretKeyFunc
+func (f *FakeSDK) QiscusSecretKey() string {
+	f.record("QiscusSecretKey")
+	if f.QiscusSecretKeyFunc != nil {
+		return f.QiscusSecretKeyFunc()
+	}
+	return ""
+}
+
+// SetAPIBase calls SetAPIBaseFunc
+func (f *FakeSDK) SetAPIBase(address string) {
+	f.record("SetAPIBase", address)
+	if f.SetAPIBaseFunc != nil {
+		f.SetAPIBaseFunc(address)
+	}
+}
+
+// SetRetryPolicy calls SetRetryPolicyFunc
+func (f *FakeSDK) SetRetryPolicy(policy *qiscus.RetryPolicy) {
+	f.record("SetRetryPolicy", policy)
+	if f.SetRetryPolicyFunc != nil {
+		f.SetRetryPolicyFunc(policy)
+	}
+}
+
+// LoginOrRegister calls LoginOrRegisterFunc
+func (f *FakeSDK) LoginOrRegister(req *sdk.LoginOrRegisterReq) (*sdk.LoginOrRegisterResponse, *qiscus.Error) {
+	f.record("LoginOrRegister", req)
+	if f.LoginOrRegisterFunc != nil {
+		return f.LoginOrRegisterFunc(req)
+	}
+	if f.LoginOrRegisterContextFunc != nil {
+		return f.LoginOrRegisterContextFunc(context.Background(), req)
+	}
+	return &sdk.LoginOrRegisterResponse{}, nil
+}
+
+// LoginOrRegisterContext calls LoginOrRegisterContextFunc
+func (f *FakeSDK) LoginOrRegisterContext(ctx context.Context, req *sdk.LoginOrRegisterReq) (*sdk.LoginOrRegisterResponse, *qiscus.Error) {
+	f.record("LoginOrRegisterContext", ctx, req)
+	if f.LoginOrRegisterContextFunc != nil {
+		return f.LoginOrRegisterContextFunc(ctx, req)
+	}
+	return &sdk.LoginOrRegisterResponse{}, nil
+}
+
+// GetUserProfile calls GetUserProfileFunc
+func (f *FakeSDK) GetUserProfile(userID string) (*sdk.GetUserProfileResponse, *qiscus.Error) {
+	f.record("GetUserProfile", userID)
+	if f.GetUserProfileFunc != nil {
+		return f.GetUserProfileFunc(userID)
+	}
+	if f.GetUserProfileContextFunc != nil {
+		return f.GetUserProfileContextFunc(context.Background(), userID)
+	}
+	return &sdk.GetUserProfileResponse{}, nil
+}
+
+// GetUserProfileContext calls GetUserProfileContextFunc
+func (f *FakeSDK) GetUserProfileContext(ctx context.Context, userID string) (*sdk.GetUserProfileResponse, *qiscus.Error) {
+	f.record("GetUserProfileContext", ctx, userID)
+	if f.GetUserProfileContextFunc != nil {
+		return f.GetUserProfileContextFunc(ctx, userID)
+	}
+	return &sdk.GetUserProfileResponse{}, nil
+}
+
+// GetUserToken calls GetUserTokenFunc
+func (f *FakeSDK) GetUserToken(userID string) (*sdk.GetUserTokenResponse, *qiscus.Error) {
+	f.record("GetUserToken", userID)
+	if f.GetUserTokenFunc != nil {
+		return f.GetUserTokenFunc(userID)
+	}
+	if f.GetUserTokenContextFunc != nil {
+		return f.GetUserTokenContextFunc(context.Background(), userID)
+	}
+	return &sdk.GetUserTokenResponse{}, nil
+}
+
+// GetUserTokenContext calls GetUserTokenContextFunc
+func (f *FakeSDK) GetUserTokenContext(ctx context.Context, userID string) (*sdk.GetUserTokenResponse, *qiscus.Error) {
+	f.record("GetUserTokenContext", ctx, userID)
+	if f.GetUserTokenContextFunc != nil {
+		return f.GetUserTokenContextFunc(ctx, userID)
+	}
+	return &sdk.GetUserTokenResponse{}, nil
+}
+
+// ResetUserToken calls ResetUserTokenFunc
+func (f *FakeSDK) ResetUserToken(userID string) (*sdk.GetUserTokenResponse, *qiscus.Error) {
+	f.record("ResetUserToken", userID)
+	if f.ResetUserTokenFunc != nil {
+		return f.ResetUserTokenFunc(userID)
+	}
+	if f.ResetUserTokenContextFunc != nil {
+		return f.ResetUserTokenContextFunc(context.Background(), userID)
+	}
+	return &sdk.GetUserTokenResponse{}, nil
+}
+
+// ResetUserTokenContext calls ResetUserTokenContextFunc
+func (f *FakeSDK) ResetUserTokenContext(ctx context.Context, userID string) (*sdk.GetUserTokenResponse, *qiscus.Error) {
+	f.record("ResetUserTokenContext", ctx, userID)
+	if f.ResetUserTokenContextFunc != nil {
+		return f.ResetUserTokenContextFunc(ctx, userID)
+	}
+	return &sdk.GetUserTokenResponse{}, nil
+}
+
+// CreateRoom calls CreateRoomFunc
+func (f *FakeSDK) CreateRoom(req *sdk.CreateRoomReq) (*sdk.CreateRoomResponse, *qiscus.Error) {
+	f.record("CreateRoom", req)
+	if f.CreateRoomFunc != nil {
+		return f.CreateRoomFunc(req)
+	}
+	if f.CreateRoomContextFunc != nil {
+		return f.CreateRoomContextFunc(context.Background(), req)
+	}
+	return &sdk.CreateRoomResponse{}, nil
+}
+
+// CreateRoomContext calls CreateRoomContextFunc
+func (f *FakeSDK) CreateRoomContext(ctx context.Context, req *sdk.CreateRoomReq) (*sdk.CreateRoomResponse, *qiscus.Error) {
+	f.record("CreateRoomContext", ctx, req)
+	if f.CreateRoomContextFunc != nil {
+		return f.CreateRoomContextFunc(ctx, req)
+	}
+	return &sdk.CreateRoomResponse{}, nil
+}
+
+// GetOrCreateRoomWithTarget calls GetOrCreateRoomWithTargetFunc
+func (f *FakeSDK) GetOrCreateRoomWithTarget(req *sdk.GetOrCreateRoomWithTargetReq) (*sdk.CreateRoomResponse, *qiscus.Error) {
+	f.record("GetOrCreateRoomWithTarget", req)
+	if f.GetOrCreateRoomWithTargetFunc != nil {
+		return f.GetOrCreateRoomWithTargetFunc(req)
+	}
+	if f.GetOrCreateRoomWithTargetContextFunc != nil {
+		return f.GetOrCreateRoomWithTargetContextFunc(context.Background(), req)
+	}
+	return &sdk.CreateRoomResponse{}, nil
+}
+
+// GetOrCreateRoomWithTargetContext calls GetOrCreateRoomWithTargetContextFunc
+func (f *FakeSDK) GetOrCreateRoomWithTargetContext(ctx context.Context, req *sdk.GetOrCreateRoomWithTargetReq) (*sdk.CreateRoomResponse, *qiscus.Error) {
+	f.record("GetOrCreateRoomWithTargetContext", ctx, req)
+	if f.GetOrCreateRoomWithTargetContextFunc != nil {
+		return f.GetOrCreateRoomWithTargetContextFunc(ctx, req)
+	}
+	return &sdk.CreateRoomResponse{}, nil
+}
+
+// GetRoomsInfo calls GetRoomsInfoFunc
+func (f *FakeSDK) GetRoomsInfo(roomIDs []string) (*sdk.GetRoomsInfoResponse, *qiscus.Error) {
+	f.record("GetRoomsInfo", roomIDs)
+	if f.GetRoomsInfoFunc != nil {
+		return f.GetRoomsInfoFunc(roomIDs)
+	}
+	if f.GetRoomsInfoContextFunc != nil {
+		return f.GetRoomsInfoContextFunc(context.Background(), roomIDs)
+	}
+	return &sdk.GetRoomsInfoResponse{}, nil
+}
+
+// GetRoomsInfoContext calls GetRoomsInfoContextFunc
+func (f *FakeSDK) GetRoomsInfoContext(ctx context.Context, roomIDs []string) (*sdk.GetRoomsInfoResponse, *qiscus.Error) {
+	f.record("GetRoomsInfoContext", ctx, roomIDs)
+	if f.GetRoomsInfoContextFunc != nil {
+		return f.GetRoomsInfoContextFunc(ctx, roomIDs)
+	}
+	return &sdk.GetRoomsInfoResponse{}, nil
+}
+
+// UpdateRoom calls UpdateRoomFunc
+func (f *FakeSDK) UpdateRoom(req *sdk.UpdateRoomReq) (*sdk.UpdateRoomResponse, *qiscus.Error) {
+	f.record("UpdateRoom", req)
+	if f.UpdateRoomFunc != nil {
+		return f.UpdateRoomFunc(req)
+	}
+	if f.UpdateRoomContextFunc != nil {
+		return f.UpdateRoomContextFunc(context.Background(), req)
+	}
+	return &sdk.UpdateRoomResponse{}, nil
+}
+
+// UpdateRoomContext calls UpdateRoomContextFunc
+func (f *FakeSDK) UpdateRoomContext(ctx context.Context, req *sdk.UpdateRoomReq) (*sdk.UpdateRoomResponse, *qiscus.Error) {
+	f.record("UpdateRoomContext", ctx, req)
+	if f.UpdateRoomContextFunc != nil {
+		return f.UpdateRoomContextFunc(ctx, req)
+	}
+	return &sdk.UpdateRoomResponse{}, nil
+}
+
+// GetRoomParticipants calls GetRoomParticipantsFunc
+func (f *FakeSDK) GetRoomParticipants(req *sdk.GetRoomParticipantsReq) (*sdk.GetRoomParticipantsResponse, *qiscus.Error) {
+	f.record("GetRoomParticipants", req)
+	if f.GetRoomParticipantsFunc != nil {
+		return f.GetRoomParticipantsFunc(req)
+	}
+	if f.GetRoomParticipantsContextFunc != nil {
+		return f.GetRoomParticipantsContextFunc(context.Background(), req)
+	}
+	return &sdk.GetRoomParticipantsResponse{}, nil
+}
+
+// GetRoomParticipantsContext calls GetRoomParticipantsContextFunc
+func (f *FakeSDK) GetRoomParticipantsContext(ctx context.Context, req *sdk.GetRoomParticipantsReq) (*sdk.GetRoomParticipantsResponse, *qiscus.Error) {
+	f.record("GetRoomParticipantsContext", ctx, req)
+	if f.GetRoomParticipantsContextFunc != nil {
+		return f.GetRoomParticipantsContextFunc(ctx, req)
+	}
+	return &sdk.GetRoomParticipantsResponse{}, nil
+}
+
+// AddRoomParticipants calls AddRoomParticipantsFunc
+func (f *FakeSDK) AddRoomParticipants(req *sdk.AddRoomParticipantsReq) (*sdk.AddRoomParticipantsResponse, *qiscus.Error) {
+	f.record("AddRoomParticipants", req)
+	if f.AddRoomParticipantsFunc != nil {
+		return f.AddRoomParticipantsFunc(req)
+	}
+	if f.AddRoomParticipantsContextFunc != nil {
+		return f.AddRoomParticipantsContextFunc(context.Background(), req)
+	}
+	return &sdk.AddRoomParticipantsResponse{}, nil
+}
+
+// AddRoomParticipantsContext calls AddRoomParticipantsContextFunc
+func (f *FakeSDK) AddRoomParticipantsContext(ctx context.Context, req *sdk.AddRoomParticipantsReq) (*sdk.AddRoomParticipantsResponse, *qiscus.Error) {
+	f.record("AddRoomParticipantsContext", ctx, req)
+	if f.AddRoomParticipantsContextFunc != nil {
+		return f.AddRoomParticipantsContextFunc(ctx, req)
+	}
+	return &sdk.AddRoomParticipantsResponse{}, nil
+}
+
+// RemoveRoomParticipants calls RemoveRoomParticipantsFunc
+func (f *FakeSDK) RemoveRoomParticipants(req *sdk.RemoveRoomParticipantsReq) (*sdk.RemoveRoomParticipantsResponse, *qiscus.Error) {
+	f.record("RemoveRoomParticipants", req)
+	if f.RemoveRoomParticipantsFunc != nil {
+		return f.RemoveRoomParticipantsFunc(req)
+	}
+	if f.RemoveRoomParticipantsContextFunc != nil {
+		return f.RemoveRoomParticipantsContextFunc(context.Background(), req)
+	}
+	return &sdk.RemoveRoomParticipantsResponse{}, nil
+}
+
+// RemoveRoomParticipantsContext calls RemoveRoomParticipantsContextFunc
+func (f *FakeSDK) RemoveRoomParticipantsContext(ctx context.Context, req *sdk.RemoveRoomParticipantsReq) (*sdk.RemoveRoomParticipantsResponse, *qiscus.Error) {
+	f.record("RemoveRoomParticipantsContext", ctx, req)
+	if f.RemoveRoomParticipantsContextFunc != nil {
+		return f.RemoveRoomParticipantsContextFunc(ctx, req)
+	}
+	return &sdk.RemoveRoomParticipantsResponse{}, nil
+}
+
+// GetUserRooms calls GetUserRoomsFunc
+func (f *FakeSDK) GetUserRooms(req *sdk.GetUserRoomsReq) (*sdk.GetUserRoomsResponse, *qiscus.Error) {
+	f.record("GetUserRooms", req)
+	if f.GetUserRoomsFunc != nil {
+		return f.GetUserRoomsFunc(req)
+	}
+	if f.GetUserRoomsContextFunc != nil {
+		return f.GetUserRoomsContextFunc(context.Background(), req)
+	}
+	return &sdk.GetUserRoomsResponse{}, nil
+}
+
+// GetUserRoomsContext calls GetUserRoomsContextFunc
+func (f *FakeSDK) GetUserRoomsContext(ctx context.Context, req *sdk.GetUserRoomsReq) (*sdk.GetUserRoomsResponse, *qiscus.Error) {
+	f.record("GetUserRoomsContext", ctx, req)
+	if f.GetUserRoomsContextFunc != nil {
+		return f.GetUserRoomsContextFunc(ctx, req)
+	}
+	return &sdk.GetUserRoomsResponse{}, nil
+}
+
+// PostComment calls PostCommentFunc
+func (f *FakeSDK) PostComment(req *sdk.PostCommentReq) (*sdk.PostCommentResponse, *qiscus.Error) {
+	f.record("PostComment", req)
+	if f.PostCommentFunc != nil {
+		return f.PostCommentFunc(req)
+	}
+	if f.PostCommentContextFunc != nil {
+		return f.PostCommentContextFunc(context.Background(), req)
+	}
+	return &sdk.PostCommentResponse{}, nil
+}
+
+// PostCommentContext calls PostCommentContextFunc
+func (f *FakeSDK) PostCommentContext(ctx context.Context, req *sdk.PostCommentReq) (*sdk.PostCommentResponse, *qiscus.Error) {
+	f.record("PostCommentContext", ctx, req)
+	if f.PostCommentContextFunc != nil {
+		return f.PostCommentContextFunc(ctx, req)
+	}
+	return &sdk.PostCommentResponse{}, nil
+}
+
+// LoadComments calls LoadCommentsFunc
+func (f *FakeSDK) LoadComments(req *sdk.LoadCommentsReq) (*sdk.LoadCommentsResponse, *qiscus.Error) {
+	f.record("LoadComments", req)
+	if f.LoadCommentsFunc != nil {
+		return f.LoadCommentsFunc(req)
+	}
+	if f.LoadCommentsContextFunc != nil {
+		return f.LoadCommentsContextFunc(context.Background(), req)
+	}
+	return &sdk.LoadCommentsResponse{}, nil
+}
+
+// LoadCommentsContext calls LoadCommentsContextFunc
+func (f *FakeSDK) LoadCommentsContext(ctx context.Context, req *sdk.LoadCommentsReq) (*sdk.LoadCommentsResponse, *qiscus.Error) {
+	f.record("LoadCommentsContext", ctx, req)
+	if f.LoadCommentsContextFunc != nil {
+		return f.LoadCommentsContextFunc(ctx, req)
+	}
+	return &sdk.LoadCommentsResponse{}, nil
+}
+
+// PostSystemEventMessage calls PostSystemEventMessageFunc
+func (f *FakeSDK) PostSystemEventMessage(req *sdk.PostSystemEventMessageReq) (*sdk.PostSystemEventMessageResponse, *qiscus.Error) {
+	f.record("PostSystemEventMessage", req)
+	if f.PostSystemEventMessageFunc != nil {
+		return f.PostSystemEventMessageFunc(req)
+	}
+	if f.PostSystemEventMessageContextFunc != nil {
+		return f.PostSystemEventMessageContextFunc(context.Background(), req)
+	}
+	return &sdk.PostSystemEventMessageResponse{}, nil
+}
+
+// PostSystemEventMessageContext calls PostSystemEventMessageContextFunc
+func (f *FakeSDK) PostSystemEventMessageContext(ctx context.Context, req *sdk.PostSystemEventMessageReq) (*sdk.PostSystemEventMessageResponse, *qiscus.Error) {
+	f.record("PostSystemEventMessageContext", ctx, req)
+	if f.PostSystemEventMessageContextFunc != nil {
+		return f.PostSystemEventMessageContextFunc(ctx, req)
+	}
+	return &sdk.PostSystemEventMessageResponse{}, nil
+}
+
+// GetUnreadCount calls GetUnreadCountFunc
+func (f *FakeSDK) GetUnreadCount(req *sdk.GetUnreadCountReq) (*sdk.GetUnreadCountResponse, *qiscus.Error) {
+	f.record("GetUnreadCount", req)
+	if f.GetUnreadCountFunc != nil {
+		return f.GetUnreadCountFunc(req)
+	}
+	if f.GetUnreadCountContextFunc != nil {
+		return f.GetUnreadCountContextFunc(context.Background(), req)
+	}
+	return &sdk.GetUnreadCountResponse{}, nil
+}
+
+// GetUnreadCountContext calls GetUnreadCountContextFunc
+func (f *FakeSDK) GetUnreadCountContext(ctx context.Context, req *sdk.GetUnreadCountReq) (*sdk.GetUnreadCountResponse, *qiscus.Error) {
+	f.record("GetUnreadCountContext", ctx, req)
+	if f.GetUnreadCountContextFunc != nil {
+		return f.GetUnreadCountContextFunc(ctx, req)
+	}
+	return &sdk.GetUnreadCountResponse{}, nil
+}
+
+// GetUsers calls GetUsersFunc
+func (f *FakeSDK) GetUsers(req *sdk.GetUsersReq) (*sdk.GetUsersResponse, *qiscus.Error) {
+	f.record("GetUsers", req)
+	if f.GetUsersFunc != nil {
+		return f.GetUsersFunc(req)
+	}
+	if f.GetUsersContextFunc != nil {
+		return f.GetUsersContextFunc(context.Background(), req)
+	}
+	return &sdk.GetUsersResponse{}, nil
+}
+
+// GetUsersContext calls GetUsersContextFunc
+func (f *FakeSDK) GetUsersContext(ctx context.Context, req *sdk.GetUsersReq) (*sdk.GetUsersResponse, *qiscus.Error) {
+	f.record("GetUsersContext", ctx, req)
+	if f.GetUsersContextFunc != nil {
+		return f.GetUsersContextFunc(ctx, req)
+	}
+	return &sdk.GetUsersResponse{}, nil
+}
+
+// LoadCommentsWithRange calls LoadCommentsWithRangeFunc
+func (f *FakeSDK) LoadCommentsWithRange(req *sdk.LoadCommentsWithRangeReq) (*sdk.LoadCommentsWithRangeResponse, *qiscus.Error) {
+	f.record("LoadCommentsWithRange", req)
+	if f.LoadCommentsWithRangeFunc != nil {
+		return f.LoadCommentsWithRangeFunc(req)
+	}
+	if f.LoadCommentsWithRangeContextFunc != nil {
+		return f.LoadCommentsWithRangeContextFunc(context.Background(), req)
+	}
+	return &sdk.LoadCommentsWithRangeResponse{}, nil
+}
+
+// LoadCommentsWithRangeContext calls LoadCommentsWithRangeContextFunc
+func (f *FakeSDK) LoadCommentsWithRangeContext(ctx context.Context, req *sdk.LoadCommentsWithRangeReq) (*sdk.LoadCommentsWithRangeResponse, *qiscus.Error) {
+	f.record("LoadCommentsWithRangeContext", ctx, req)
+	if f.LoadCommentsWithRangeContextFunc != nil {
+		return f.LoadCommentsWithRangeContextFunc(ctx, req)
+	}
+	return &sdk.LoadCommentsWithRangeResponse{}, nil
+}
+
+// GetOrCreateChannel calls GetOrCreateChannelFunc
+func (f *FakeSDK) GetOrCreateChannel(req *sdk.GetOrCreateChannelReq) (*sdk.GetOrCreateChannelResponse, *qiscus.Error) {
+	f.record("GetOrCreateChannel", req)
+	if f.GetOrCreateChannelFunc != nil {
+		return f.GetOrCreateChannelFunc(req)
+	}
+	if f.GetOrCreateChannelContextFunc != nil {
+		return f.GetOrCreateChannelContextFunc(context.Background(), req)
+	}
+	return &sdk.GetOrCreateChannelResponse{}, nil
+}
+
+// GetOrCreateChannelContext calls GetOrCreateChannelContextFunc
+func (f *FakeSDK) GetOrCreateChannelContext(ctx context.Context, req *sdk.GetOrCreateChannelReq) (*sdk.GetOrCreateChannelResponse, *qiscus.Error) {
+	f.record("GetOrCreateChannelContext", ctx, req)
+	if f.GetOrCreateChannelContextFunc != nil {
+		return f.GetOrCreateChannelContextFunc(ctx, req)
+	}
+	return &sdk.GetOrCreateChannelResponse{}, nil
+}
+
+// GetAverageReplyTimeUser calls GetAverageReplyTimeUserFunc
+func (f *FakeSDK) GetAverageReplyTimeUser(req *sdk.GetAverageReplyTimeUserReq) (*sdk.GetAverageReplyTimeUserResponse, *qiscus.Error) {
+	f.record("GetAverageReplyTimeUser", req)
+	if f.GetAverageReplyTimeUserFunc != nil {
+		return f.GetAverageReplyTimeUserFunc(req)
+	}
+	if f.GetAverageReplyTimeUserContextFunc != nil {
+		return f.GetAverageReplyTimeUserContextFunc(context.Background(), req)
+	}
+	return &sdk.GetAverageReplyTimeUserResponse{}, nil
+}
+
+// GetAverageReplyTimeUserContext calls GetAverageReplyTimeUserContextFunc
+func (f *FakeSDK) GetAverageReplyTimeUserContext(ctx context.Context, req *sdk.GetAverageReplyTimeUserReq) (*sdk.GetAverageReplyTimeUserResponse, *qiscus.Error) {
+	f.record("GetAverageReplyTimeUserContext", ctx, req)
+	if f.GetAverageReplyTimeUserContextFunc != nil {
+		return f.GetAverageReplyTimeUserContextFunc(ctx, req)
+	}
+	return &sdk.GetAverageReplyTimeUserResponse{}, nil
+}
+
+// GetUserResponseRate calls GetUserResponseRateFunc
+func (f *FakeSDK) GetUserResponseRate(req *sdk.GetUserResponseRateReq) (*sdk.GetUserResponseRateResponse, *qiscus.Error) {
+	f.record("GetUserResponseRate", req)
+	if f.GetUserResponseRateFunc != nil {
+		return f.GetUserResponseRateFunc(req)
+	}
+	if f.GetUserResponseRateContextFunc != nil {
+		return f.GetUserResponseRateContextFunc(context.Background(), req)
+	}
+	return &sdk.GetUserResponseRateResponse{}, nil
+}
+
+// GetUserResponseRateContext calls GetUserResponseRateContextFunc
+func (f *FakeSDK) GetUserResponseRateContext(ctx context.Context, req *sdk.GetUserResponseRateReq) (*sdk.GetUserResponseRateResponse, *qiscus.Error) {
+	f.record("GetUserResponseRateContext", ctx, req)
+	if f.GetUserResponseRateContextFunc != nil {
+		return f.GetUserResponseRateContextFunc(ctx, req)
+	}
+	return &sdk.GetUserResponseRateResponse{}, nil
+}
+
+// GetWebhookLogs calls GetWebhookLogsFunc
+func (f *FakeSDK) GetWebhookLogs(req *sdk.GetWebhookLogsReq) (*sdk.GetWebhookLogsResponse, *qiscus.Error) {
+	f.record("GetWebhookLogs", req)
+	if f.GetWebhookLogsFunc != nil {
+		return f.GetWebhookLogsFunc(req)
+	}
+	if f.GetWebhookLogsContextFunc != nil {
+		return f.GetWebhookLogsContextFunc(context.Background(), req)
+	}
+	return &sdk.GetWebhookLogsResponse{}, nil
+}
+
+// GetWebhookLogsContext calls GetWebhookLogsContextFunc
+func (f *FakeSDK) GetWebhookLogsContext(ctx context.Context, req *sdk.GetWebhookLogsReq) (*sdk.GetWebhookLogsResponse, *qiscus.Error) {
+	f.record("GetWebhookLogsContext", ctx, req)
+	if f.GetWebhookLogsContextFunc != nil {
+		return f.GetWebhookLogsContextFunc(ctx, req)
+	}
+	return &sdk.GetWebhookLogsResponse{}, nil
+}
+
+// DeactivateUser calls DeactivateUserFunc
+func (f *FakeSDK) DeactivateUser(req *sdk.DeactivateUserReq) (*sdk.DeactivateUserResponse, *qiscus.Error) {
+	f.record("DeactivateUser", req)
+	if f.DeactivateUserFunc != nil {
+		return f.DeactivateUserFunc(req)
+	}
+	if f.DeactivateUserContextFunc != nil {
+		return f.DeactivateUserContextFunc(context.Background(), req)
+	}
+	return &sdk.DeactivateUserResponse{}, nil
+}
+
+// DeactivateUserContext calls DeactivateUserContextFunc
+func (f *FakeSDK) DeactivateUserContext(ctx context.Context, req *sdk.DeactivateUserReq) (*sdk.DeactivateUserResponse, *qiscus.Error) {
+	f.record("DeactivateUserContext", ctx, req)
+	if f.DeactivateUserContextFunc != nil {
+		return f.DeactivateUserContextFunc(ctx, req)
+	}
+	return &sdk.DeactivateUserResponse{}, nil
+}
+
+// ReactivateUser calls ReactivateUserFunc
+func (f *FakeSDK) ReactivateUser(req *sdk.ReactivateUserReq) (*sdk.ReactivateUserResponse, *qiscus.Error) {
+	f.record("ReactivateUser", req)
+	if f.ReactivateUserFunc != nil {
+		return f.ReactivateUserFunc(req)
+	}
+	if f.ReactivateUserContextFunc != nil {
+		return f.ReactivateUserContextFunc(context.Background(), req)
+	}
+	return &sdk.ReactivateUserResponse{}, nil
+}
+
+// ReactivateUserContext calls ReactivateUserContextFunc
+func (f *FakeSDK) ReactivateUserContext(ctx context.Context, req *sdk.ReactivateUserReq) (*sdk.ReactivateUserResponse, *qiscus.Error) {
+	f.record("ReactivateUserContext", ctx, req)
+	if f.ReactivateUserContextFunc != nil {
+		return f.ReactivateUserContextFunc(ctx, req)
+	}
+	return &sdk.ReactivateUserResponse{}, nil
+}
+
+// DeleteComments calls DeleteCommentsFunc
+func (f *FakeSDK) DeleteComments(req *sdk.DeleteCommentsReq) (*sdk.DeleteCommentsResponse, *qiscus.Error) {
+	f.record("DeleteComments", req)
+	if f.DeleteCommentsFunc != nil {
+		return f.DeleteCommentsFunc(req)
+	}
+	if f.DeleteCommentsContextFunc != nil {
+		return f.DeleteCommentsContextFunc(context.Background(), req)
+	}
+	return &sdk.DeleteCommentsResponse{}, nil
+}
+
+// DeleteCommentsContext calls DeleteCommentsContextFunc
+func (f *FakeSDK) DeleteCommentsContext(ctx context.Context, req *sdk.DeleteCommentsReq) (*sdk.DeleteCommentsResponse, *qiscus.Error) {
+	f.record("DeleteCommentsContext", ctx, req)
+	if f.DeleteCommentsContextFunc != nil {
+		return f.DeleteCommentsContextFunc(ctx, req)
+	}
+	return &sdk.DeleteCommentsResponse{}, nil
+}
+
+// ClearRoomMessages calls ClearRoomMessagesFunc
+func (f *FakeSDK) ClearRoomMessages(req *sdk.ClearRoomMessagesReq) (*sdk.ClearRoomMessagesResponse, *qiscus.Error) {
+	f.record("ClearRoomMessages", req)
+	if f.ClearRoomMessagesFunc != nil {
+		return f.ClearRoomMessagesFunc(req)
+	}
+	if f.ClearRoomMessagesContextFunc != nil {
+		return f.ClearRoomMessagesContextFunc(context.Background(), req)
+	}
+	return &sdk.ClearRoomMessagesResponse{}, nil
+}
+
+// ClearRoomMessagesContext calls ClearRoomMessagesContextFunc
+func (f *FakeSDK) ClearRoomMessagesContext(ctx context.Context, req *sdk.ClearRoomMessagesReq) (*sdk.ClearRoomMessagesResponse, *qiscus.Error) {
+	f.record("ClearRoomMessagesContext", ctx, req)
+	if f.ClearRoomMessagesContextFunc != nil {
+		return f.ClearRoomMessagesContextFunc(ctx, req)
+	}
+	return &sdk.ClearRoomMessagesResponse{}, nil
+}
+
+// UpdateComment calls UpdateCommentFunc
+func (f *FakeSDK) UpdateComment(req *sdk.UpdateCommentReq) (*sdk.UpdateCommentResponse, *qiscus.Error) {
+	f.record("UpdateComment", req)
+	if f.UpdateCommentFunc != nil {
+		return f.UpdateCommentFunc(req)
+	}
+	if f.UpdateCommentContextFunc != nil {
+		return f.UpdateCommentContextFunc(context.Background(), req)
+	}
+	return &sdk.UpdateCommentResponse{}, nil
+}
+
+// UpdateCommentContext calls UpdateCommentContextFunc
+func (f *FakeSDK) UpdateCommentContext(ctx context.Context, req *sdk.UpdateCommentReq) (*sdk.UpdateCommentResponse, *qiscus.Error) {
+	f.record("UpdateCommentContext", ctx, req)
+	if f.UpdateCommentContextFunc != nil {
+		return f.UpdateCommentContextFunc(ctx, req)
+	}
+	return &sdk.UpdateCommentResponse{}, nil
+}
+
+// MarkCommentsRead calls MarkCommentsReadFunc
+func (f *FakeSDK) MarkCommentsRead(req *sdk.MarkCommentsReq) (*sdk.MarkCommentsResponse, *qiscus.Error) {
+	f.record("MarkCommentsRead", req)
+	if f.MarkCommentsReadFunc != nil {
+		return f.MarkCommentsReadFunc(req)
+	}
+	if f.MarkCommentsReadContextFunc != nil {
+		return f.MarkCommentsReadContextFunc(context.Background(), req)
+	}
+	return &sdk.MarkCommentsResponse{}, nil
+}
+
+// MarkCommentsReadContext calls MarkCommentsReadContextFunc
+func (f *FakeSDK) MarkCommentsReadContext(ctx context.Context, req *sdk.MarkCommentsReq) (*sdk.MarkCommentsResponse, *qiscus.Error) {
+	f.record("MarkCommentsReadContext", ctx, req)
+	if f.MarkCommentsReadContextFunc != nil {
+		return f.MarkCommentsReadContextFunc(ctx, req)
+	}
+	return &sdk.MarkCommentsResponse{}, nil
+}
+
+// MarkCommentsDelivered calls MarkCommentsDeliveredFunc
+func (f *FakeSDK) MarkCommentsDelivered(req *sdk.MarkCommentsReq) (*sdk.MarkCommentsResponse, *qiscus.Error) {
+	f.record("MarkCommentsDelivered", req)
+	if f.MarkCommentsDeliveredFunc != nil {
+		return f.MarkCommentsDeliveredFunc(req)
+	}
+	if f.MarkCommentsDeliveredContextFunc != nil {
+		return f.MarkCommentsDeliveredContextFunc(context.Background(), req)
+	}
+	return &sdk.MarkCommentsResponse{}, nil
+}
+
+// MarkCommentsDeliveredContext calls MarkCommentsDeliveredContextFunc
+func (f *FakeSDK) MarkCommentsDeliveredContext(ctx context.Context, req *sdk.MarkCommentsReq) (*sdk.MarkCommentsResponse, *qiscus.Error) {
+	f.record("MarkCommentsDeliveredContext", ctx, req)
+	if f.MarkCommentsDeliveredContextFunc != nil {
+		return f.MarkCommentsDeliveredContextFunc(ctx, req)
+	}
+	return &sdk.MarkCommentsResponse{}, nil
+}
+
+// GetCommentReceipts calls GetCommentReceiptsFunc
+func (f *FakeSDK) GetCommentReceipts(req *sdk.GetCommentReceiptsReq) (*sdk.GetCommentReceiptsResponse, *qiscus.Error) {
+	f.record("GetCommentReceipts", req)
+	if f.GetCommentReceiptsFunc != nil {
+		return f.GetCommentReceiptsFunc(req)
+	}
+	if f.GetCommentReceiptsContextFunc != nil {
+		return f.GetCommentReceiptsContextFunc(context.Background(), req)
+	}
+	return &sdk.GetCommentReceiptsResponse{}, nil
+}
+
+// GetCommentReceiptsContext calls GetCommentReceiptsContextFunc
+func (f *FakeSDK) GetCommentReceiptsContext(ctx context.Context, req *sdk.GetCommentReceiptsReq) (*sdk.GetCommentReceiptsResponse, *qiscus.Error) {
+	f.record("GetCommentReceiptsContext", ctx, req)
+	if f.GetCommentReceiptsContextFunc != nil {
+		return f.GetCommentReceiptsContextFunc(ctx, req)
+	}
+	return &sdk.GetCommentReceiptsResponse{}, nil
+}
+
+// Upload calls UploadFunc
+func (f *FakeSDK) Upload(ctx context.Context, filename string, r io.Reader, opts ...sdk.UploadOption) (*sdk.UploadResponse, *qiscus.Error) {
+	f.record("Upload", ctx, filename, r, opts)
+	if f.UploadFunc != nil {
+		return f.UploadFunc(ctx, filename, r, opts...)
+	}
+	return &sdk.UploadResponse{}, nil
+}
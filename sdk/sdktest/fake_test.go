@@ -0,0 +1,41 @@
+package sdktest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+	"github.com/Qiscus-Integration/qiscus-go/sdk"
+	"github.com/Qiscus-Integration/qiscus-go/sdk/sdktest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeSDK(t *testing.T) {
+	fake := &sdktest.FakeSDK{
+		PostCommentContextFunc: func(ctx context.Context, req *sdk.PostCommentReq) (*sdk.PostCommentResponse, *qiscus.Error) {
+			resp := &sdk.PostCommentResponse{}
+			resp.Results.Comment.Message = req.Message
+			return resp, nil
+		},
+	}
+
+	var c sdk.SDK = fake
+
+	// The plain method falls back to the Context variant
+	resp, err := c.PostComment(&sdk.PostCommentReq{RoomID: "123123", Message: "hello"})
+	assert.Nil(t, err)
+	assert.Equal(t, resp.Results.Comment.Message, "hello")
+
+	// Methods not faked return empty results
+	profile, err := c.GetUserProfile("guest@mail.com")
+	assert.Nil(t, err)
+	assert.NotNil(t, profile)
+
+	calls := fake.CallsTo("PostComment")
+	assert.Len(t, calls, 1)
+	assert.Equal(t, calls[0].Args[0].(*sdk.PostCommentReq).Message, "hello")
+	assert.Len(t, fake.Calls(), 2)
+
+	fake.Reset()
+	assert.Empty(t, fake.Calls())
+}
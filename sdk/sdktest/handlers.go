@@ -0,0 +1,1031 @@
+package sdktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func (s *Server) routes() http.Handler {
+	mux := http.NewServeMux()
+
+	routes := map[string]struct {
+		method  string
+		handler http.HandlerFunc
+	}{
+		"login_or_register":              {http.MethodPost, s.loginOrRegister},
+		"user_profile":                   {http.MethodGet, s.userProfile},
+		"get_user_token":                 {http.MethodGet, s.getUserToken},
+		"reset_user_token":               {http.MethodPost, s.resetUserToken},
+		"create_room":                    {http.MethodPost, s.createRoomHandler},
+		"get_or_create_room_with_target": {http.MethodPost, s.getOrCreateRoomWithTarget},
+		"get_rooms_info":                 {http.MethodGet, s.getRoomsInfo},
+		"update_room":                    {http.MethodPost, s.updateRoom},
+		"get_room_participants":          {http.MethodGet, s.getRoomParticipants},
+		"add_room_participants":          {http.MethodPost, s.addRoomParticipants},
+		"remove_room_participants":       {http.MethodPost, s.removeRoomParticipants},
+		"get_user_rooms":                 {http.MethodGet, s.getUserRooms},
+		"post_comment":                   {http.MethodPost, s.postCommentHandler},
+		"load_comments":                  {http.MethodGet, s.loadComments},
+		"post_system_event_message":      {http.MethodPost, s.postSystemEventMessage},
+		"get_unread_count":               {http.MethodGet, s.getUnreadCount},
+		"get_user_list":                  {http.MethodGet, s.getUserList},
+		"load_comments_with_range":       {http.MethodGet, s.loadCommentsWithRange},
+		"get_or_create_channel":          {http.MethodPost, s.getOrCreateChannel},
+		"get_average_reply_time_user":    {http.MethodGet, s.getAverageReplyTimeUser},
+		"get_user_response_rate":         {http.MethodGet, s.getUserResponseRate},
+		"webhook_logs":                   {http.MethodGet, s.webhookLogs},
+		"deactivate_users":               {http.MethodDelete, s.deactivateUsers},
+		"reactivate_users":               {http.MethodPost, s.reactivateUsers},
+		"upload":                         {http.MethodPost, s.upload},
+		"delete_messages":                {http.MethodDelete, s.deleteMessages},
+		"clear_room_messages":            {http.MethodDelete, s.clearRoomMessages},
+		"update_message":                 {http.MethodPost, s.updateMessage},
+		"update_comment_status":          {http.MethodPost, s.updateCommentStatus},
+		"comment_receipts":               {http.MethodGet, s.commentReceipts},
+	}
+
+	for name, route := range routes {
+		route := route
+		mux.HandleFunc("/api/v2.1/rest/"+name, func(w http.ResponseWriter, req *http.Request) {
+			if req.Method != route.method {
+				writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+				return
+			}
+			if req.Header.Get("QISCUS_SDK_APP_ID") != s.AppID || req.Header.Get("QISCUS_SDK_SECRET") != s.SecretKey {
+				writeError(w, http.StatusUnauthorized, "unauthorized, invalid app id or secret key")
+				return
+			}
+
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			route.handler(w, req)
+		})
+	}
+
+	// Uploaded files are public, as on Qiscus file hosting
+	mux.HandleFunc("/files/", s.serveUpload)
+
+	return mux
+}
+
+func (s *Server) loginOrRegister(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		UserID    string `json:"user_id"`
+		Password  string `json:"password"`
+		Username  string `json:"username"`
+		AvatarURL string `json:"avatar_url"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+	if body.UserID == "" {
+		writeError(w, http.StatusBadRequest, "user_id is required")
+		return
+	}
+
+	u := s.upsertUser(body.UserID, body.Username, body.Password, body.AvatarURL)
+	writeJSON(w, map[string]interface{}{"user": userJSON(u)})
+}
+
+func (s *Server) userProfile(w http.ResponseWriter, req *http.Request) {
+	u, ok := s.lookupUser(w, req.URL.Query().Get("user_id"))
+	if !ok {
+		return
+	}
+
+	writeJSON(w, map[string]interface{}{"user": userJSON(u)})
+}
+
+func (s *Server) getUserToken(w http.ResponseWriter, req *http.Request) {
+	u, ok := s.lookupUser(w, req.URL.Query().Get("user_id"))
+	if !ok {
+		return
+	}
+
+	writeJSON(w, map[string]interface{}{"token": u.Token})
+}
+
+func (s *Server) resetUserToken(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		UserID string `json:"user_id"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+
+	u, ok := s.lookupUser(w, body.UserID)
+	if !ok {
+		return
+	}
+
+	s.nextID++
+	u.Token = newToken(u.UserID, s.nextID)
+	writeJSON(w, map[string]interface{}{"token": u.Token})
+}
+
+func (s *Server) createRoomHandler(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		RoomName      string   `json:"room_name"`
+		Creator       string   `json:"creator"`
+		Participants  []string `json:"participants"`
+		RoomAvatarURL string   `json:"room_avatar_url"`
+		RoomOptions   string   `json:"room_options"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+	if !s.requireUsers(w, append([]string{body.Creator}, body.Participants...)) {
+		return
+	}
+
+	room := s.createRoom(body.RoomName, body.RoomAvatarURL, body.RoomOptions, "group", append([]string{body.Creator}, body.Participants...))
+	writeJSON(w, map[string]interface{}{"room": roomJSON(room)})
+}
+
+func (s *Server) getOrCreateRoomWithTarget(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		UserIDs     []string `json:"user_ids"`
+		RoomOptions string   `json:"room_options"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+	if len(body.UserIDs) != 2 {
+		writeError(w, http.StatusBadRequest, "user_ids must contain exactly 2 users")
+		return
+	}
+	if !s.requireUsers(w, body.UserIDs) {
+		return
+	}
+
+	key := targetKey(body.UserIDs)
+	if roomID, ok := s.channels[key]; ok {
+		writeJSON(w, map[string]interface{}{"room": roomJSON(s.rooms[roomID])})
+		return
+	}
+
+	room := s.createRoom("", "", body.RoomOptions, "single", body.UserIDs)
+	room.UniqueID = key
+	s.channels[key] = room.RoomID
+	writeJSON(w, map[string]interface{}{"room": roomJSON(room)})
+}
+
+func (s *Server) getRoomsInfo(w http.ResponseWriter, req *http.Request) {
+	rooms := []interface{}{}
+	for _, roomID := range req.URL.Query()["room_ids[]"] {
+		if room, ok := s.rooms[roomID]; ok {
+			rooms = append(rooms, roomJSON(room))
+		}
+	}
+
+	writeJSON(w, map[string]interface{}{"rooms": rooms})
+}
+
+func (s *Server) updateRoom(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		RoomID      string `json:"room_id"`
+		RoomName    string `json:"room_name"`
+		RoomOptions string `json:"room_options"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+
+	room, ok := s.lookupRoom(w, body.RoomID)
+	if !ok {
+		return
+	}
+
+	changed := false
+	if body.RoomName != "" && body.RoomName != room.RoomName {
+		room.RoomName = body.RoomName
+		changed = true
+	}
+	if body.RoomOptions != "" && body.RoomOptions != room.RoomOptions {
+		room.RoomOptions = body.RoomOptions
+		changed = true
+	}
+
+	writeJSON(w, map[string]interface{}{"changed": changed, "room": roomJSON(room)})
+}
+
+func (s *Server) getRoomParticipants(w http.ResponseWriter, req *http.Request) {
+	room, ok := s.lookupRoom(w, req.URL.Query().Get("room_id"))
+	if !ok {
+		return
+	}
+
+	page, limit := pagination(req)
+	start, end := pageBounds(len(room.Participants), page, limit)
+
+	participants := []interface{}{}
+	for _, userID := range room.Participants[start:end] {
+		participants = append(participants, userJSON(s.users[userID]))
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"meta": map[string]interface{}{
+			"current_page": page,
+			"per_page":     limit,
+			"total":        len(room.Participants),
+		},
+		"participants": participants,
+	})
+}
+
+func (s *Server) addRoomParticipants(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		RoomID  string   `json:"room_id"`
+		UserIDs []string `json:"user_ids"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+
+	room, ok := s.lookupRoom(w, body.RoomID)
+	if !ok || !s.requireUsers(w, body.UserIDs) {
+		return
+	}
+
+	writeJSON(w, map[string]interface{}{"participants_added": s.usersJSON(s.addParticipants(room, body.UserIDs))})
+}
+
+func (s *Server) removeRoomParticipants(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		RoomID  string   `json:"room_id"`
+		UserIDs []string `json:"user_ids"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+
+	room, ok := s.lookupRoom(w, body.RoomID)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, map[string]interface{}{"participants_removed": s.usersJSON(s.removeParticipants(room, body.UserIDs))})
+}
+
+func (s *Server) getUserRooms(w http.ResponseWriter, req *http.Request) {
+	u, ok := s.lookupUser(w, req.URL.Query().Get("user_id"))
+	if !ok {
+		return
+	}
+
+	var roomIDs []string
+	for _, roomID := range s.roomOrder {
+		if s.rooms[roomID].hasParticipant(u.UserID) {
+			roomIDs = append(roomIDs, roomID)
+		}
+	}
+
+	page, limit := pagination(req)
+	start, end := pageBounds(len(roomIDs), page, limit)
+
+	rooms := []interface{}{}
+	for _, roomID := range roomIDs[start:end] {
+		rooms = append(rooms, roomJSON(s.rooms[roomID]))
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"meta": map[string]interface{}{
+			"current_page": page,
+			"total_room":   len(roomIDs),
+		},
+		"rooms": rooms,
+	})
+}
+
+func (s *Server) postCommentHandler(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		UserID  string          `json:"user_id"`
+		RoomID  string          `json:"room_id"`
+		Message string          `json:"message"`
+		Type    string          `json:"type"`
+		Extras  json.RawMessage `json:"extras"`
+		Payload json.RawMessage `json:"payload"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+
+	room, ok := s.lookupRoom(w, body.RoomID)
+	if !ok {
+		return
+	}
+	if _, ok := s.lookupUser(w, body.UserID); !ok {
+		return
+	}
+	if !room.hasParticipant(body.UserID) {
+		writeError(w, http.StatusForbidden, fmt.Sprintf("user %s is not a participant of room %s", body.UserID, room.RoomID))
+		return
+	}
+	if body.Type == "" {
+		body.Type = "text"
+	}
+
+	c := s.postComment(room, body.UserID, body.Message, body.Type, body.Payload, body.Extras)
+	writeJSON(w, map[string]interface{}{"comment": s.commentJSON(c)})
+}
+
+func (s *Server) loadComments(w http.ResponseWriter, req *http.Request) {
+	room, ok := s.lookupRoom(w, req.URL.Query().Get("room_id"))
+	if !ok {
+		return
+	}
+
+	// Newest comments first
+	all := s.comments[room.RoomID]
+	newest := make([]*Comment, 0, len(all))
+	for i := len(all) - 1; i >= 0; i-- {
+		newest = append(newest, all[i])
+	}
+
+	page, limit := pagination(req)
+	start, end := pageBounds(len(newest), page, limit)
+
+	writeJSON(w, map[string]interface{}{"comments": s.commentsJSON(newest[start:end])})
+}
+
+func (s *Server) postSystemEventMessage(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		SystemEventType string          `json:"system_event_type"`
+		RoomID          string          `json:"room_id"`
+		Message         string          `json:"message"`
+		Payload         json.RawMessage `json:"payload"`
+		Extras          json.RawMessage `json:"extras"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+
+	room, ok := s.lookupRoom(w, body.RoomID)
+	if !ok {
+		return
+	}
+
+	payload, _ := json.Marshal(map[string]interface{}{
+		"type":    body.SystemEventType,
+		"payload": orEmptyObject(body.Payload),
+	})
+
+	c := s.postComment(room, "", body.Message, "system_event", payload, body.Extras)
+	writeJSON(w, map[string]interface{}{"comment": s.commentJSON(c)})
+}
+
+func (s *Server) getUnreadCount(w http.ResponseWriter, req *http.Request) {
+	u, ok := s.lookupUser(w, req.URL.Query().Get("user_id"))
+	if !ok {
+		return
+	}
+
+	counts := []interface{}{}
+	for _, roomID := range req.URL.Query()["room_ids[]"] {
+		if _, ok := s.rooms[roomID]; !ok {
+			continue
+		}
+		counts = append(counts, map[string]interface{}{
+			"room_id":      roomID,
+			"unread_count": s.unreadCount(roomID, u.UserID),
+		})
+	}
+
+	writeJSON(w, map[string]interface{}{"unread_counts": counts})
+}
+
+func (s *Server) getUserList(w http.ResponseWriter, req *http.Request) {
+	showAll, _ := strconv.ParseBool(req.URL.Query().Get("show_all"))
+
+	// Default order is created_at desc
+	var users []*User
+	for i := len(s.userOrder) - 1; i >= 0; i-- {
+		u := s.users[s.userOrder[i]]
+		if u.Active || showAll {
+			users = append(users, u)
+		}
+	}
+	if strings.HasPrefix(req.URL.Query().Get("order_query"), "created_at asc") {
+		sort.SliceStable(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+	}
+
+	page, limit := pagination(req)
+	start, end := pageBounds(len(users), page, limit)
+
+	list := []interface{}{}
+	for _, u := range users[start:end] {
+		list = append(list, map[string]interface{}{
+			"active":     u.Active,
+			"avatar_url": u.AvatarURL,
+			"created_at": u.CreatedAt,
+			"email":      u.UserID,
+			"extras":     u.Extras,
+			"id":         u.ID,
+			"name":       u.Username,
+			"updated_at": u.UpdatedAt,
+			"username":   u.Username,
+		})
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"meta": map[string]interface{}{
+			"total_data": len(users),
+			"total_page": (len(users) + limit - 1) / limit,
+		},
+		"users": list,
+	})
+}
+
+func (s *Server) loadCommentsWithRange(w http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	room, ok := s.lookupRoom(w, q.Get("room_id"))
+	if !ok {
+		return
+	}
+
+	first, err1 := strconv.ParseInt(q.Get("first_comment_id"), 10, 64)
+	last, err2 := strconv.ParseInt(q.Get("last_comment_id"), 10, 64)
+	if err1 != nil || err2 != nil {
+		writeError(w, http.StatusBadRequest, "first_comment_id and last_comment_id must be numbers")
+		return
+	}
+
+	var comments []*Comment
+	for _, c := range s.comments[room.RoomID] {
+		if c.ID >= first && c.ID <= last {
+			comments = append(comments, c)
+		}
+	}
+
+	writeJSON(w, map[string]interface{}{"comments": s.commentsJSON(comments)})
+}
+
+func (s *Server) getOrCreateChannel(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		UniqueID      string   `json:"unique_id"`
+		RoomName      string   `json:"room_name"`
+		Participants  []string `json:"participants"`
+		RoomAvatarURL string   `json:"room_avatar_url"`
+		RoomOptions   string   `json:"room_options"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+	if body.UniqueID == "" {
+		writeError(w, http.StatusBadRequest, "unique_id is required")
+		return
+	}
+	if !s.requireUsers(w, body.Participants) {
+		return
+	}
+
+	if roomID, ok := s.channels[body.UniqueID]; ok {
+		room := s.rooms[roomID]
+		changed := len(s.addParticipants(room, body.Participants)) > 0
+		writeJSON(w, map[string]interface{}{"changed": changed, "room": roomJSON(room)})
+		return
+	}
+
+	room := s.createRoom(body.RoomName, body.RoomAvatarURL, body.RoomOptions, "channel", body.Participants)
+	room.UniqueID = body.UniqueID
+	s.channels[body.UniqueID] = room.RoomID
+	writeJSON(w, map[string]interface{}{"changed": true, "room": roomJSON(room)})
+}
+
+func (s *Server) getAverageReplyTimeUser(w http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	u, ok := s.lookupUser(w, q.Get("user_id"))
+	if !ok {
+		return
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"data": map[string]interface{}{
+			"duration": s.replyDuration(u.UserID),
+			"user_id":  u.UserID,
+		},
+		"start_time": q.Get("start_time"),
+		"end_time":   q.Get("end_time"),
+	})
+}
+
+func (s *Server) getUserResponseRate(w http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	userIDs := q["user_ids[]"]
+	if !s.requireUsers(w, userIDs) {
+		return
+	}
+
+	data := []interface{}{}
+	for _, userID := range userIDs {
+		data = append(data, map[string]interface{}{
+			"duration": s.replyDuration(userID),
+			"user_id":  userID,
+		})
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"data":       data,
+		"start_time": q.Get("start_time"),
+		"end_time":   q.Get("end_time"),
+	})
+}
+
+// replyDuration returns the average, longest and shortest reply times of the user, in seconds
+func (s *Server) replyDuration(userID string) map[string]interface{} {
+	var total, longest, shortest time.Duration
+	replies := s.replyTimes[userID]
+	for i, d := range replies {
+		total += d
+		if d > longest {
+			longest = d
+		}
+		if i == 0 || d < shortest {
+			shortest = d
+		}
+	}
+
+	average := 0
+	if len(replies) > 0 {
+		average = int((total / time.Duration(len(replies))).Seconds())
+	}
+
+	return map[string]interface{}{
+		"average":  average,
+		"longest":  int(longest.Seconds()),
+		"shortest": int(shortest.Seconds()),
+	}
+}
+
+func (s *Server) webhookLogs(w http.ResponseWriter, req *http.Request) {
+	// The fake server does not deliver webhooks
+	writeJSON(w, map[string]interface{}{"webhook_logs": []interface{}{}})
+}
+
+func (s *Server) deactivateUsers(w http.ResponseWriter, req *http.Request) {
+	s.setActive(w, req, false)
+}
+
+func (s *Server) reactivateUsers(w http.ResponseWriter, req *http.Request) {
+	s.setActive(w, req, true)
+}
+
+func (s *Server) setActive(w http.ResponseWriter, req *http.Request, active bool) {
+	var body struct {
+		UserIDs []string `json:"user_ids"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+	if !s.requireUsers(w, body.UserIDs) {
+		return
+	}
+
+	for _, userID := range body.UserIDs {
+		s.users[userID].Active = active
+	}
+
+	action := "deactivated"
+	if active {
+		action = "reactivated"
+	}
+	writeJSON(w, map[string]interface{}{"message": fmt.Sprintf("%d users %s", len(body.UserIDs), action)})
+}
+
+func (s *Server) lookupUser(w http.ResponseWriter, userID string) (*User, bool) {
+	u, ok := s.users[userID]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("user %s not found", userID))
+		return nil, false
+	}
+	return u, true
+}
+
+func (s *Server) lookupRoom(w http.ResponseWriter, roomID string) (*Room, bool) {
+	room, ok := s.rooms[roomID]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("room %s not found", roomID))
+		return nil, false
+	}
+	return room, true
+}
+
+// requireUsers writes a not found error for the first unknown user
+func (s *Server) requireUsers(w http.ResponseWriter, userIDs []string) bool {
+	for _, userID := range userIDs {
+		if _, ok := s.lookupUser(w, userID); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) usersJSON(userIDs []string) []interface{} {
+	users := []interface{}{}
+	for _, userID := range userIDs {
+		users = append(users, userJSON(s.users[userID]))
+	}
+	return users
+}
+
+func (s *Server) commentJSON(c *Comment) map[string]interface{} {
+	user := map[string]interface{}{}
+	if u, ok := s.users[c.UserID]; ok {
+		user = userJSON(u)
+	}
+
+	return map[string]interface{}{
+		"extras":     c.Extras,
+		"id":         c.ID,
+		"is_deleted": c.Deleted,
+		"message":    c.Message,
+		"payload":    c.Payload,
+		"timestamp":  c.Timestamp,
+		"type":       c.Type,
+		"unique_id":  c.UniqueID,
+		"user":       user,
+	}
+}
+
+func (s *Server) commentsJSON(comments []*Comment) []interface{} {
+	list := []interface{}{}
+	for _, c := range comments {
+		list = append(list, s.commentJSON(c))
+	}
+	return list
+}
+
+func userJSON(u *User) map[string]interface{} {
+	return map[string]interface{}{
+		"active":     u.Active,
+		"avatar_url": u.AvatarURL,
+		"extras":     u.Extras,
+		"user_id":    u.UserID,
+		"username":   u.Username,
+	}
+}
+
+func roomJSON(r *Room) map[string]interface{} {
+	return map[string]interface{}{
+		"room_avatar_url": r.RoomAvatarURL,
+		"room_channel_id": r.UniqueID,
+		"room_id":         r.RoomID,
+		"room_name":       r.RoomName,
+		"room_options":    r.RoomOptions,
+		"room_type":       r.RoomType,
+	}
+}
+
+func (s *Server) deleteMessages(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		UserID      string   `json:"user_id"`
+		CommentIDs  []int64  `json:"comment_ids"`
+		UniqueIDs   []string `json:"unique_ids"`
+		ForEveryone bool     `json:"is_delete_for_everyone"`
+		HardDelete  bool     `json:"is_hard_delete"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+
+	if _, ok := s.lookupUser(w, body.UserID); !ok {
+		return
+	}
+
+	// Every comment is checked before any is deleted
+	var comments []*Comment
+	add := func(fn func(*Comment) bool) bool {
+		c, ok := s.lookupComment(w, body.UserID, fn)
+		if !ok {
+			return false
+		}
+		for _, found := range comments {
+			if found == c {
+				return true
+			}
+		}
+		comments = append(comments, c)
+		return true
+	}
+	for _, id := range body.CommentIDs {
+		id := id
+		if !add(func(c *Comment) bool { return c.ID == id }) {
+			return
+		}
+	}
+	for _, uniqueID := range body.UniqueIDs {
+		uniqueID := uniqueID
+		if !add(func(c *Comment) bool { return c.UniqueID == uniqueID }) {
+			return
+		}
+	}
+
+	deleted := []interface{}{}
+	for _, c := range comments {
+		switch {
+		case !body.ForEveryone:
+			if !contains(c.DeletedFor, body.UserID) {
+				c.DeletedFor = append(c.DeletedFor, body.UserID)
+			}
+		case body.HardDelete:
+			s.removeComment(c)
+			c.Deleted = true
+		default:
+			c.Deleted = true
+			c.Message = ""
+			c.Payload = json.RawMessage(`{}`)
+			c.Extras = json.RawMessage(`{}`)
+		}
+		deleted = append(deleted, s.commentJSON(c))
+	}
+
+	writeJSON(w, map[string]interface{}{"comments": deleted})
+}
+
+func (s *Server) clearRoomMessages(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		RoomIDs []string `json:"room_ids"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+
+	for _, roomID := range body.RoomIDs {
+		if _, ok := s.lookupRoom(w, roomID); !ok {
+			return
+		}
+	}
+
+	rooms := []interface{}{}
+	for _, roomID := range body.RoomIDs {
+		delete(s.comments, roomID)
+		rooms = append(rooms, roomJSON(s.rooms[roomID]))
+	}
+
+	writeJSON(w, map[string]interface{}{"rooms": rooms})
+}
+
+func (s *Server) updateMessage(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		UserID    string          `json:"user_id"`
+		CommentID int64           `json:"comment_id"`
+		UniqueID  string          `json:"unique_id"`
+		Message   string          `json:"message"`
+		Payload   json.RawMessage `json:"payload"`
+		Extras    json.RawMessage `json:"extras"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+
+	c, ok := s.lookupComment(w, body.UserID, func(c *Comment) bool {
+		return (body.CommentID != 0 && c.ID == body.CommentID) || (body.UniqueID != "" && c.UniqueID == body.UniqueID)
+	})
+	if !ok {
+		return
+	}
+
+	if c.Deleted {
+		writeError(w, http.StatusBadRequest, "comment is deleted")
+		return
+	}
+
+	c.Message = body.Message
+	if len(body.Payload) > 0 {
+		c.Payload = body.Payload
+	}
+	if len(body.Extras) > 0 {
+		c.Extras = body.Extras
+	}
+
+	writeJSON(w, map[string]interface{}{"comment": s.commentJSON(c)})
+}
+
+func (s *Server) updateCommentStatus(w http.ResponseWriter, req *http.Request) {
+	var body struct {
+		UserID                string `json:"user_id"`
+		RoomID                string `json:"room_id"`
+		LastCommentReadID     int64  `json:"last_comment_read_id"`
+		LastCommentReceivedID int64  `json:"last_comment_received_id"`
+	}
+	if !decodeBody(w, req, &body) {
+		return
+	}
+
+	u, ok := s.lookupUser(w, body.UserID)
+	if !ok {
+		return
+	}
+	room, ok := s.lookupRoom(w, body.RoomID)
+	if !ok {
+		return
+	}
+	if !room.hasParticipant(u.UserID) {
+		writeError(w, http.StatusForbidden, fmt.Sprintf("user %s is not a participant of room %s", u.UserID, room.RoomID))
+		return
+	}
+
+	for _, id := range []int64{body.LastCommentReadID, body.LastCommentReceivedID} {
+		if id != 0 && s.roomComment(room.RoomID, id) == nil {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("comment %d not found in room %s", id, room.RoomID))
+			return
+		}
+	}
+
+	if body.LastCommentReadID != 0 {
+		s.markRead(room.RoomID, u.UserID, body.LastCommentReadID)
+	}
+	if body.LastCommentReceivedID != 0 {
+		s.markDelivered(room.RoomID, u.UserID, body.LastCommentReceivedID)
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"user_id":                  u.UserID,
+		"room_id":                  room.RoomID,
+		"last_comment_read_id":     s.lastRead[room.RoomID][u.UserID],
+		"last_comment_received_id": s.lastDelivered[room.RoomID][u.UserID],
+	})
+}
+
+func (s *Server) commentReceipts(w http.ResponseWriter, req *http.Request) {
+	room, ok := s.lookupRoom(w, req.URL.Query().Get("room_id"))
+	if !ok {
+		return
+	}
+
+	commentID, _ := strconv.ParseInt(req.URL.Query().Get("comment_id"), 10, 64)
+	c := s.roomComment(room.RoomID, commentID)
+	if c == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("comment %d not found in room %s", commentID, room.RoomID))
+		return
+	}
+
+	receipts := []interface{}{}
+	for _, userID := range room.Participants {
+		if userID == c.UserID {
+			continue
+		}
+
+		lastRead, lastDelivered := s.lastRead[room.RoomID][userID], s.lastDelivered[room.RoomID][userID]
+		status := "sent"
+		switch {
+		case lastRead >= c.ID:
+			status = "read"
+		case lastDelivered >= c.ID:
+			status = "delivered"
+		}
+
+		user := map[string]interface{}{"user_id": userID}
+		if u, ok := s.users[userID]; ok {
+			user = userJSON(u)
+		}
+
+		receipts = append(receipts, map[string]interface{}{
+			"user":                     user,
+			"status":                   status,
+			"last_comment_read_id":     lastRead,
+			"last_comment_received_id": lastDelivered,
+		})
+	}
+
+	writeJSON(w, map[string]interface{}{"receipts": receipts})
+}
+
+// roomComment returns the comment of the room, or nil
+func (s *Server) roomComment(roomID string, commentID int64) *Comment {
+	for _, c := range s.comments[roomID] {
+		if c.ID == commentID {
+			return c
+		}
+	}
+	return nil
+}
+
+// lookupComment returns the first comment matching fn, or writes an error when it is not found or not sent by userID
+func (s *Server) lookupComment(w http.ResponseWriter, userID string, fn func(*Comment) bool) (*Comment, bool) {
+	for _, roomID := range s.roomOrder {
+		for _, c := range s.comments[roomID] {
+			if !fn(c) {
+				continue
+			}
+			if c.UserID != userID {
+				writeError(w, http.StatusForbidden, "comment is not sent by "+userID)
+				return nil, false
+			}
+			return c, true
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "comment not found")
+	return nil, false
+}
+
+// removeComment removes the comment from its room
+func (s *Server) removeComment(c *Comment) {
+	comments := s.comments[c.RoomID]
+	for i := range comments {
+		if comments[i] == c {
+			s.comments[c.RoomID] = append(comments[:i:i], comments[i+1:]...)
+			return
+		}
+	}
+}
+
+func (s *Server) upload(w http.ResponseWriter, req *http.Request) {
+	mr, err := req.MultipartReader()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid multipart body: "+err.Error())
+		return
+	}
+
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid multipart body: "+err.Error())
+			return
+		}
+
+		if part.FormName() != "file" || part.FileName() == "" {
+			continue
+		}
+
+		data, err := ioutil.ReadAll(part)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid multipart body: "+err.Error())
+			return
+		}
+
+		s.nextID++
+		u := &Upload{
+			URL:         fmt.Sprintf("%s/files/%d/%s", s.URL, s.nextID, url.PathEscape(part.FileName())),
+			Filename:    part.FileName(),
+			ContentType: part.Header.Get("Content-Type"),
+			Data:        data,
+		}
+		s.uploads[u.URL] = u
+
+		writeJSON(w, map[string]interface{}{"file": map[string]interface{}{"url": u.URL}})
+		return
+	}
+
+	writeError(w, http.StatusBadRequest, "file is required")
+}
+
+func (s *Server) serveUpload(w http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	u, ok := s.uploads[s.URL+req.URL.EscapedPath()]
+	s.mu.Unlock()
+
+	if !ok || req.Method != http.MethodGet {
+		http.NotFound(w, req)
+		return
+	}
+
+	w.Header().Set("Content-Type", u.ContentType)
+	_, _ = w.Write(u.Data)
+}
+
+func decodeBody(w http.ResponseWriter, req *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(req.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// pagination returns page and limit query parameters, defaulting to 1 and 20
+func pagination(req *http.Request) (int, int) {
+	page, _ := strconv.Atoi(req.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	limit, _ := strconv.Atoi(req.URL.Query().Get("limit"))
+	if limit < 1 {
+		limit = 20
+	}
+	return page, limit
+}
+
+func pageBounds(total, page, limit int) (int, int) {
+	start := (page - 1) * limit
+	if start > total {
+		start = total
+	}
+	end := start + limit
+	if end > total {
+		end = total
+	}
+	return start, end
+}
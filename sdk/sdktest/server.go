@@ -0,0 +1,375 @@
+// Package sdktest provides an in-memory fake of Qiscus SDK REST API for integration tests.
+//
+// The fake keeps users, rooms, participants and comments in memory, so a flow of calls behaves
+// consistently, e.g. a comment posted with PostComment is returned by LoadComments:
+//
+//	fake := sdktest.NewServer("app-id", "secret-key")
+//	defer fake.Close()
+//
+//	c := sdk.NewSDK("app-id", "secret-key")
+//	c.SetAPIBase(fake.URL)
+package sdktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:generate go run ../../internal/cmd/fakegen -dir .. -iface SDK -name FakeSDK -out fake.go
+
+// Server is an in-memory fake of Qiscus SDK REST API
+type Server struct {
+	*httptest.Server
+
+	AppID     string
+	SecretKey string
+
+	// Now returns the time used for created comments and users, default time.Now
+	Now func() time.Time
+
+	mu            sync.Mutex
+	users         map[string]*User
+	userOrder     []string
+	rooms         map[string]*Room
+	roomOrder     []string
+	channels      map[string]string // unique ID to room ID
+	comments      map[string][]*Comment
+	lastRead      map[string]map[string]int64 // room ID to user ID to last read comment ID
+	lastDelivered map[string]map[string]int64 // room ID to user ID to last delivered comment ID
+	replyTimes    map[string][]time.Duration  // user ID to reply times
+	uploads       map[string]*Upload          // URL to uploaded file
+	nextID        int64
+	nextCommentID int64
+}
+
+// User is a user stored in the fake server
+type User struct {
+	ID        int64
+	UserID    string
+	Username  string
+	Password  string
+	AvatarURL string
+	Token     string
+	Active    bool
+	Extras    json.RawMessage
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Room is a room stored in the fake server
+type Room struct {
+	RoomID        string
+	RoomName      string
+	RoomAvatarURL string
+	RoomOptions   string
+	RoomType      string // single, group or channel
+	UniqueID      string
+	Participants  []string
+}
+
+// Comment is a comment stored in the fake server
+type Comment struct {
+	ID        int64
+	RoomID    string
+	UserID    string
+	Message   string
+	Type      string
+	Payload   json.RawMessage
+	Extras    json.RawMessage
+	UniqueID  string
+	Timestamp time.Time
+
+	Deleted    bool     // deleted for everyone, the message, payload and extras are cleared
+	DeletedFor []string // users who deleted the comment for themselves
+}
+
+// Upload is a file uploaded to the fake server, it is served at its URL
+type Upload struct {
+	URL         string
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// NewServer starts a fake server accepting the given credentials, call Close when done
+func NewServer(appID, secretKey string) *Server {
+	s := &Server{
+		AppID:         appID,
+		SecretKey:     secretKey,
+		Now:           time.Now,
+		users:         make(map[string]*User),
+		rooms:         make(map[string]*Room),
+		channels:      make(map[string]string),
+		comments:      make(map[string][]*Comment),
+		lastRead:      make(map[string]map[string]int64),
+		lastDelivered: make(map[string]map[string]int64),
+		replyTimes:    make(map[string][]time.Duration),
+		uploads:       make(map[string]*Upload),
+	}
+	s.Server = httptest.NewServer(s.routes())
+
+	return s
+}
+
+// AddUser registers a user, as LoginOrRegister would do
+func (s *Server) AddUser(userID, username string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.upsertUser(userID, username, "", "")
+}
+
+// AddReplyTimes records reply times of the user, returned by the reply time analytics endpoints.
+// The time window of the analytics requests is not simulated, every reply time is counted.
+func (s *Server) AddReplyTimes(userID string, replies ...time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.replyTimes[userID] = append(s.replyTimes[userID], replies...)
+}
+
+// GetUser returns a copy of the stored user
+func (s *Server) GetUser(userID string) (User, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[userID]
+	if !ok {
+		return User{}, false
+	}
+	return *u, true
+}
+
+// GetRoom returns a copy of the stored room
+func (s *Server) GetRoom(roomID string) (Room, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.rooms[roomID]
+	if !ok {
+		return Room{}, false
+	}
+
+	room := *r
+	room.Participants = append([]string(nil), r.Participants...)
+	return room, true
+}
+
+// GetComments returns a copy of the comments of a room, oldest first
+func (s *Server) GetComments(roomID string) []Comment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var comments []Comment
+	for _, c := range s.comments[roomID] {
+		comment := *c
+		comment.DeletedFor = append([]string(nil), c.DeletedFor...)
+		comments = append(comments, comment)
+	}
+	return comments
+}
+
+// GetUpload returns a copy of the file uploaded at url
+func (s *Server) GetUpload(url string) (Upload, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.uploads[url]
+	if !ok {
+		return Upload{}, false
+	}
+
+	upload := *u
+	upload.Data = append([]byte(nil), u.Data...)
+	return upload, true
+}
+
+func (s *Server) upsertUser(userID, username, password, avatarURL string) *User {
+	now := s.Now().UTC()
+
+	u, ok := s.users[userID]
+	if !ok {
+		s.nextID++
+		u = &User{
+			ID:        s.nextID,
+			UserID:    userID,
+			Token:     newToken(userID, s.nextID),
+			Active:    true,
+			Extras:    json.RawMessage(`{}`),
+			CreatedAt: now,
+		}
+		s.users[userID] = u
+		s.userOrder = append(s.userOrder, userID)
+	}
+
+	if username != "" {
+		u.Username = username
+	}
+	if password != "" {
+		u.Password = password
+	}
+	if avatarURL != "" {
+		u.AvatarURL = avatarURL
+	}
+	u.UpdatedAt = now
+
+	return u
+}
+
+func (s *Server) createRoom(name, avatarURL, options, roomType string, participants []string) *Room {
+	s.nextID++
+	room := &Room{
+		RoomID:        strconv.FormatInt(s.nextID, 10),
+		RoomName:      name,
+		RoomAvatarURL: avatarURL,
+		RoomOptions:   options,
+		RoomType:      roomType,
+	}
+	s.rooms[room.RoomID] = room
+	s.roomOrder = append(s.roomOrder, room.RoomID)
+	s.addParticipants(room, participants)
+
+	return room
+}
+
+func (s *Server) addParticipants(room *Room, userIDs []string) []string {
+	var added []string
+	for _, userID := range userIDs {
+		if room.hasParticipant(userID) {
+			continue
+		}
+		room.Participants = append(room.Participants, userID)
+		added = append(added, userID)
+	}
+	return added
+}
+
+func (s *Server) removeParticipants(room *Room, userIDs []string) []string {
+	var removed []string
+	remaining := room.Participants[:0]
+	for _, userID := range room.Participants {
+		if contains(userIDs, userID) {
+			removed = append(removed, userID)
+			continue
+		}
+		remaining = append(remaining, userID)
+	}
+	room.Participants = remaining
+	return removed
+}
+
+func (s *Server) postComment(room *Room, userID, message, commentType string, payload, extras json.RawMessage) *Comment {
+	s.nextCommentID++
+	c := &Comment{
+		ID:        s.nextCommentID,
+		RoomID:    room.RoomID,
+		UserID:    userID,
+		Message:   message,
+		Type:      commentType,
+		Payload:   orEmptyObject(payload),
+		Extras:    orEmptyObject(extras),
+		UniqueID:  fmt.Sprintf("sdktest-%d", s.nextCommentID),
+		Timestamp: s.Now().UTC(),
+	}
+	s.comments[room.RoomID] = append(s.comments[room.RoomID], c)
+
+	// The sender has read every comment up to its own
+	if userID != "" {
+		s.markRead(room.RoomID, userID, c.ID)
+	}
+
+	return c
+}
+
+// markRead moves the read pointer of the user forward, a read comment is delivered as well
+func (s *Server) markRead(roomID, userID string, commentID int64) {
+	movePointer(s.lastRead, roomID, userID, commentID)
+	movePointer(s.lastDelivered, roomID, userID, commentID)
+}
+
+// markDelivered moves the delivered pointer of the user forward
+func (s *Server) markDelivered(roomID, userID string, commentID int64) {
+	movePointer(s.lastDelivered, roomID, userID, commentID)
+}
+
+// movePointer sets the comment pointer of the user in the room, pointers never move backward
+func movePointer(pointers map[string]map[string]int64, roomID, userID string, commentID int64) {
+	if pointers[roomID] == nil {
+		pointers[roomID] = make(map[string]int64)
+	}
+	if commentID > pointers[roomID][userID] {
+		pointers[roomID][userID] = commentID
+	}
+}
+
+func (s *Server) unreadCount(roomID, userID string) int {
+	lastRead := s.lastRead[roomID][userID]
+
+	count := 0
+	for _, c := range s.comments[roomID] {
+		if c.ID > lastRead && c.UserID != userID {
+			count++
+		}
+	}
+	return count
+}
+
+func (r *Room) hasParticipant(userID string) bool {
+	return contains(r.Participants, userID)
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func orEmptyObject(raw json.RawMessage) json.RawMessage {
+	if len(raw) == 0 || string(raw) == "null" {
+		return json.RawMessage(`{}`)
+	}
+	return raw
+}
+
+func newToken(userID string, seq int64) string {
+	return fmt.Sprintf("sdktest-token-%d-%s", seq, strings.ReplaceAll(userID, "@", "-"))
+}
+
+// targetKey returns the key of a single room between users, independent of their order
+func targetKey(userIDs []string) string {
+	sorted := append([]string(nil), userIDs...)
+	sort.Strings(sorted)
+	return "single:" + strings.Join(sorted, ",")
+}
+
+// writeJSON writes {"results": results, "status": 200}
+func writeJSON(w http.ResponseWriter, results interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"results": results,
+		"status":  http.StatusOK,
+	})
+}
+
+// writeError writes the SDK error envelope
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": map[string]interface{}{
+			"message":           message,
+			"detailed_messages": []string{message},
+		},
+		"status": status,
+	})
+}
@@ -0,0 +1,330 @@
+package sdktest_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+	"github.com/Qiscus-Integration/qiscus-go/sdk"
+	"github.com/Qiscus-Integration/qiscus-go/sdk/sdktest"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	qiscusAppID     = "test-qiscus-app-id"
+	qiscusSecretKey = "test-qiscus-secret-key"
+)
+
+func newClient(t *testing.T) (sdk.SDK, *sdktest.Server) {
+	fake := sdktest.NewServer(qiscusAppID, qiscusSecretKey)
+	t.Cleanup(fake.Close)
+
+	return sdk.NewSDK(qiscusAppID, qiscusSecretKey, sdk.WithAPIBase(fake.URL)), fake
+}
+
+func TestUsers(t *testing.T) {
+	c, fake := newClient(t)
+
+	login, err := c.LoginOrRegister(&sdk.LoginOrRegisterReq{UserID: "guest@mail.com", Password: "secret", Username: "Guest"})
+	assert.Nil(t, err)
+	assert.Equal(t, login.Results.User.UserID, "guest@mail.com")
+	assert.True(t, login.Results.User.Active)
+
+	profile, err := c.GetUserProfile("guest@mail.com")
+	assert.Nil(t, err)
+	assert.Equal(t, profile.Results.User.Username, "Guest")
+
+	token, err := c.GetUserToken("guest@mail.com")
+	assert.Nil(t, err)
+	assert.NotEmpty(t, token.Results.Token)
+
+	reset, err := c.ResetUserToken("guest@mail.com")
+	assert.Nil(t, err)
+	assert.NotEqual(t, reset.Results.Token, token.Results.Token)
+
+	_, err = c.GetUserProfile("unknown@mail.com")
+	assert.True(t, errors.Is(err, qiscus.ErrNotFound))
+
+	fake.AddUser("other@mail.com", "Other")
+	_, err = c.DeactivateUser(&sdk.DeactivateUserReq{UserIDs: []string{"other@mail.com"}})
+	assert.Nil(t, err)
+
+	users, err := c.GetUsers(&sdk.GetUsersReq{})
+	assert.Nil(t, err)
+	assert.Len(t, users.Results.Users, 1)
+	assert.Equal(t, users.Results.Users[0].Email, "guest@mail.com")
+
+	users, err = c.GetUsers(&sdk.GetUsersReq{ShowAll: true})
+	assert.Nil(t, err)
+	assert.Len(t, users.Results.Users, 2)
+
+	_, err = c.ReactivateUser(&sdk.ReactivateUserReq{UserIDs: []string{"other@mail.com"}})
+	assert.Nil(t, err)
+	u, _ := fake.GetUser("other@mail.com")
+	assert.True(t, u.Active)
+}
+
+func TestRoomsAndComments(t *testing.T) {
+	c, fake := newClient(t)
+	fake.AddUser("alice", "Alice")
+	fake.AddUser("bob", "Bob")
+	fake.AddUser("carol", "Carol")
+
+	room, err := c.CreateRoom(&sdk.CreateRoomReq{RoomName: "Team", Creator: "alice", Participants: []string{"bob"}})
+	assert.Nil(t, err)
+	roomID := room.Results.Room.RoomID
+	assert.Equal(t, room.Results.Room.RoomType, "group")
+
+	added, err := c.AddRoomParticipants(&sdk.AddRoomParticipantsReq{RoomID: roomID, UserIDs: []string{"carol", "bob"}})
+	assert.Nil(t, err)
+	assert.Len(t, added.Results.ParticipantsAdded, 1)
+
+	participants, err := c.GetRoomParticipants(&sdk.GetRoomParticipantsReq{RoomID: roomID})
+	assert.Nil(t, err)
+	assert.Equal(t, participants.Results.Meta.Total, 3)
+
+	for _, message := range []string{"one", "two", "three"} {
+		_, err = c.PostComment(&sdk.PostCommentReq{UserID: "alice", RoomID: roomID, Message: message, Type: "text"})
+		assert.Nil(t, err)
+	}
+
+	comments, err := c.LoadComments(&sdk.LoadCommentsReq{RoomID: roomID, Limit: 2})
+	assert.Nil(t, err)
+	assert.Len(t, comments.Results.Comments, 2)
+	assert.Equal(t, comments.Results.Comments[0].Message, "three")
+	assert.Equal(t, comments.Results.Comments[0].User.UserID, "alice")
+
+	unread, err := c.GetUnreadCount(&sdk.GetUnreadCountReq{UserID: "bob", RoomIDs: []string{roomID}})
+	assert.Nil(t, err)
+	assert.Equal(t, unread.Results.UnreadCounts[0].UnreadCount, 3)
+
+	unread, err = c.GetUnreadCount(&sdk.GetUnreadCountReq{UserID: "alice", RoomIDs: []string{roomID}})
+	assert.Nil(t, err)
+	assert.Equal(t, unread.Results.UnreadCounts[0].UnreadCount, 0)
+
+	removed, err := c.RemoveRoomParticipants(&sdk.RemoveRoomParticipantsReq{RoomID: roomID, UserIds: []string{"carol"}})
+	assert.Nil(t, err)
+	assert.Len(t, removed.Results.ParticipantsRemoved, 1)
+
+	_, err = c.PostComment(&sdk.PostCommentReq{UserID: "carol", RoomID: roomID, Message: "hi", Type: "text"})
+	assert.NotNil(t, err)
+	assert.Equal(t, err.GetAPIMessage(), "user carol is not a participant of room "+roomID)
+
+	rooms, err := c.GetUserRooms(&sdk.GetUserRoomsReq{UserID: "bob"})
+	assert.Nil(t, err)
+	assert.Len(t, rooms.Results.Rooms, 1)
+
+	var messages []string
+	pager := sdk.Comments(context.Background(), c, &sdk.LoadCommentsReq{RoomID: roomID, Limit: 2})
+	for pager.Next() {
+		messages = append(messages, pager.Item().Message)
+	}
+	assert.Nil(t, pager.Err())
+	assert.Equal(t, messages, []string{"three", "two", "one"})
+}
+
+func TestSingleRoomAndChannel(t *testing.T) {
+	c, fake := newClient(t)
+	fake.AddUser("alice", "Alice")
+	fake.AddUser("bob", "Bob")
+
+	r1, err := c.GetOrCreateRoomWithTarget(&sdk.GetOrCreateRoomWithTargetReq{UserIDs: []string{"alice", "bob"}})
+	assert.Nil(t, err)
+	r2, err := c.GetOrCreateRoomWithTarget(&sdk.GetOrCreateRoomWithTargetReq{UserIDs: []string{"bob", "alice"}})
+	assert.Nil(t, err)
+	assert.Equal(t, r1.Results.Room.RoomID, r2.Results.Room.RoomID)
+
+	ch, err := c.GetOrCreateChannel(&sdk.GetOrCreateChannelReq{UniqueID: "news", RoomName: "News", Participants: []string{"alice"}})
+	assert.Nil(t, err)
+	assert.True(t, ch.Results.Changed)
+
+	ch, err = c.GetOrCreateChannel(&sdk.GetOrCreateChannelReq{UniqueID: "news", Participants: []string{"alice"}})
+	assert.Nil(t, err)
+	assert.False(t, ch.Results.Changed)
+
+	info, err := c.GetRoomsInfo([]string{r1.Results.Room.RoomID, ch.Results.Room.RoomID})
+	assert.Nil(t, err)
+	assert.Len(t, info.Results.Rooms, 2)
+}
+
+func TestReplyTimes(t *testing.T) {
+	c, fake := newClient(t)
+	fake.AddUser("alice", "Alice")
+	fake.AddUser("bob", "Bob")
+	fake.AddReplyTimes("alice", 10*time.Second, 30*time.Second)
+
+	average, err := c.GetAverageReplyTimeUser(&sdk.GetAverageReplyTimeUserReq{UserID: "alice"})
+	assert.Nil(t, err)
+	assert.Equal(t, average.Results.Data.Duration, sdk.ReplyDuration{Average: 20, Longest: 30, Shortest: 10})
+
+	report, err := sdk.GetResponseRateReport(context.Background(), c, &sdk.GetUserResponseRateReq{UserIDs: []string{"bob", "alice"}})
+	assert.Nil(t, err)
+	assert.Equal(t, report.Users[0].UserID, "alice")
+	assert.False(t, report.Users[1].HasReplies())
+	assert.Equal(t, report.Average, 20*time.Second)
+
+	_, err = c.GetUserResponseRate(&sdk.GetUserResponseRateReq{UserIDs: []string{"alice", "unknown"}})
+	assert.True(t, errors.Is(err, qiscus.ErrNotFound))
+}
+
+func TestDeleteAndUpdateComments(t *testing.T) {
+	c, fake := newClient(t)
+	fake.AddUser("alice", "Alice")
+	fake.AddUser("bob", "Bob")
+
+	room, err := c.CreateRoom(&sdk.CreateRoomReq{RoomName: "Team", Creator: "alice", Participants: []string{"bob"}})
+	assert.Nil(t, err)
+	roomID := room.Results.Room.RoomID
+
+	var posted []sdk.Comment
+	for _, message := range []string{"one", "two", "three", "four"} {
+		resp, err := c.PostComment(&sdk.PostCommentReq{UserID: "alice", RoomID: roomID, Message: message, Type: "text"})
+		assert.Nil(t, err)
+		posted = append(posted, resp.Results.Comment)
+	}
+
+	updated, err := c.UpdateComment(&sdk.UpdateCommentReq{UserID: "alice", UniqueID: posted[0].UniqueID, Message: "one, edited"})
+	assert.Nil(t, err)
+	assert.Equal(t, updated.Comment().ID, posted[0].ID)
+	assert.Equal(t, updated.Comment().Message, "one, edited")
+
+	// Only the sender can update or delete a comment
+	_, err = c.UpdateComment(&sdk.UpdateCommentReq{UserID: "bob", CommentID: posted[0].ID, Message: "hacked"})
+	assert.True(t, errors.Is(err, qiscus.ErrUnauthorized))
+	_, err = c.DeleteComments(&sdk.DeleteCommentsReq{UserID: "bob", CommentIDs: []int{posted[0].ID}, ForEveryone: true})
+	assert.True(t, errors.Is(err, qiscus.ErrUnauthorized))
+
+	// Deleted for the sender only, the comment is kept for everyone else
+	_, err = c.DeleteComments(&sdk.DeleteCommentsReq{UserID: "alice", CommentIDs: []int{posted[1].ID}})
+	assert.Nil(t, err)
+
+	// Soft deleted for everyone, the comment is kept as deleted
+	deleted, err := c.DeleteComments(&sdk.DeleteCommentsReq{UserID: "alice", UniqueIDs: []string{posted[2].UniqueID}, ForEveryone: true})
+	assert.Nil(t, err)
+	assert.True(t, deleted.Comments()[0].Deleted)
+	assert.Equal(t, deleted.Comments()[0].Message, "")
+
+	_, err = c.UpdateComment(&sdk.UpdateCommentReq{UserID: "alice", CommentID: posted[2].ID, Message: "three, edited"})
+	assert.True(t, errors.Is(err, qiscus.ErrValidation))
+
+	// Hard deleted for everyone, the comment is removed
+	_, err = c.DeleteComments(&sdk.DeleteCommentsReq{UserID: "alice", CommentIDs: []int{posted[3].ID}, ForEveryone: true, HardDelete: true})
+	assert.Nil(t, err)
+
+	comments := fake.GetComments(roomID)
+	assert.Len(t, comments, 3)
+	assert.Equal(t, comments[0].Message, "one, edited")
+	assert.Equal(t, comments[1].DeletedFor, []string{"alice"})
+	assert.True(t, comments[2].Deleted)
+
+	_, err = c.DeleteComments(&sdk.DeleteCommentsReq{UserID: "alice", CommentIDs: []int{posted[3].ID}, ForEveryone: true})
+	assert.True(t, errors.Is(err, qiscus.ErrNotFound))
+
+	cleared, err := c.ClearRoomMessages(&sdk.ClearRoomMessagesReq{RoomIDs: []string{roomID}})
+	assert.Nil(t, err)
+	assert.Equal(t, cleared.Rooms()[0].RoomID, roomID)
+	assert.Len(t, fake.GetComments(roomID), 0)
+
+	_, err = c.ClearRoomMessages(&sdk.ClearRoomMessagesReq{RoomIDs: []string{"unknown"}})
+	assert.True(t, errors.Is(err, qiscus.ErrNotFound))
+}
+
+func TestReceipts(t *testing.T) {
+	c, fake := newClient(t)
+	fake.AddUser("alice", "Alice")
+	fake.AddUser("bob", "Bob")
+	fake.AddUser("carol", "Carol")
+
+	room, err := c.CreateRoom(&sdk.CreateRoomReq{RoomName: "Team", Creator: "alice", Participants: []string{"bob", "carol"}})
+	assert.Nil(t, err)
+	roomID := room.Results.Room.RoomID
+
+	var ids []int
+	for _, message := range []string{"one", "two", "three"} {
+		resp, err := c.PostComment(&sdk.PostCommentReq{UserID: "alice", RoomID: roomID, Message: message, Type: "text"})
+		assert.Nil(t, err)
+		ids = append(ids, resp.Results.Comment.ID)
+	}
+
+	unreadOf := func(userID string) int {
+		unread, err := c.GetUnreadCount(&sdk.GetUnreadCountReq{UserID: userID, RoomIDs: []string{roomID}})
+		assert.Nil(t, err)
+		return unread.Results.UnreadCounts[0].UnreadCount
+	}
+
+	// Bob read up to the second comment, carol received every comment without reading
+	marked, err := c.MarkCommentsRead(&sdk.MarkCommentsReq{UserID: "bob", RoomID: roomID, CommentID: ids[1]})
+	assert.Nil(t, err)
+	assert.Equal(t, marked.Results.LastReadCommentID, ids[1])
+	assert.Equal(t, marked.Results.LastDeliveredCommentID, ids[1])
+	_, err = c.MarkCommentsDelivered(&sdk.MarkCommentsReq{UserID: "carol", RoomID: roomID, CommentID: ids[2]})
+	assert.Nil(t, err)
+
+	assert.Equal(t, unreadOf("bob"), 1)
+	assert.Equal(t, unreadOf("carol"), 3)
+
+	// Pointers never move backward
+	marked, err = c.MarkCommentsRead(&sdk.MarkCommentsReq{UserID: "bob", RoomID: roomID, CommentID: ids[0]})
+	assert.Nil(t, err)
+	assert.Equal(t, marked.Results.LastReadCommentID, ids[1])
+
+	receipts, err := c.GetCommentReceipts(&sdk.GetCommentReceiptsReq{RoomID: roomID, CommentID: ids[1]})
+	assert.Nil(t, err)
+	assert.Len(t, receipts.Receipts(), 2)
+	assert.Equal(t, receipts.ReadBy(), []sdk.User{receipts.Receipts()[0].User})
+	assert.Equal(t, receipts.Receipts()[0].User.UserID, "bob")
+	assert.Equal(t, receipts.Receipts()[1].Status, sdk.ReceiptStatusDelivered)
+
+	receipts, err = c.GetCommentReceipts(&sdk.GetCommentReceiptsReq{RoomID: roomID, CommentID: ids[2]})
+	assert.Nil(t, err)
+	assert.Equal(t, receipts.Receipts()[0].Status, sdk.ReceiptStatusSent)
+	assert.Len(t, receipts.DeliveredTo(), 1)
+
+	_, err = c.MarkCommentsRead(&sdk.MarkCommentsReq{UserID: "bob", RoomID: roomID, CommentID: 999})
+	assert.True(t, errors.Is(err, qiscus.ErrNotFound))
+
+	fake.AddUser("dave", "Dave")
+	_, err = c.MarkCommentsRead(&sdk.MarkCommentsReq{UserID: "dave", RoomID: roomID, CommentID: ids[0]})
+	assert.True(t, errors.Is(err, qiscus.ErrUnauthorized))
+}
+
+func TestUpload(t *testing.T) {
+	c, fake := newClient(t)
+	fake.AddUser("guest@mail.com", "Guest")
+	room, err := c.CreateRoom(&sdk.CreateRoomReq{RoomName: "Room", Creator: "guest@mail.com"})
+	assert.Nil(t, err)
+
+	uploaded, err := c.Upload(context.Background(), "notes.txt", strings.NewReader("hello"))
+	assert.Nil(t, err)
+
+	upload, ok := fake.GetUpload(uploaded.URL())
+	assert.True(t, ok)
+	assert.Equal(t, upload.Filename, "notes.txt")
+	assert.Equal(t, upload.ContentType, "text/plain; charset=utf-8")
+
+	// The file is served at its URL
+	res, rerr := http.Get(uploaded.URL())
+	assert.Nil(t, rerr)
+	body, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	assert.Equal(t, string(body), "hello")
+
+	msg, merr := sdk.NewFileAttachmentMessage("guest@mail.com", room.Results.Room.RoomID, sdk.FileAttachmentPayload{URL: uploaded.URL(), FileName: "notes.txt"})
+	assert.Nil(t, merr)
+	_, err = c.PostComment(msg)
+	assert.Nil(t, err)
+}
+
+func TestUnauthorized(t *testing.T) {
+	fake := sdktest.NewServer(qiscusAppID, qiscusSecretKey)
+	defer fake.Close()
+
+	c := sdk.NewSDK(qiscusAppID, "wrong-secret", sdk.WithAPIBase(fake.URL))
+	_, err := c.GetUserToken("guest@mail.com")
+	assert.True(t, errors.Is(err, qiscus.ErrUnauthorized))
+}
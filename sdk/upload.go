@@ -0,0 +1,230 @@
+package sdk
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+)
+
+// DefaultMaxUploadSize is the default size limit of Upload, in bytes
+const DefaultMaxUploadSize int64 = 20 << 20
+
+// ErrFileTooLarge is the raw error of Upload when the file is larger than the size limit
+var ErrFileTooLarge = errors.New("qiscus: file too large")
+
+// sniffLen is the number of bytes used to detect the content type, see http.DetectContentType
+const sniffLen = 512
+
+// UploadOption configures Upload
+type UploadOption func(*uploadConfig)
+
+type uploadConfig struct {
+	maxSize     int64
+	contentType string
+	progress    func(written, total int64)
+}
+
+// WithMaxUploadSize sets the size limit of the file in bytes, default DefaultMaxUploadSize
+func WithMaxUploadSize(n int64) UploadOption {
+	return func(c *uploadConfig) {
+		c.maxSize = n
+	}
+}
+
+// WithUploadContentType sets the content type of the file, by default it is detected from its content and name
+func WithUploadContentType(contentType string) UploadOption {
+	return func(c *uploadConfig) {
+		c.contentType = contentType
+	}
+}
+
+// WithUploadProgress sets a callback called as the file is sent, with the bytes written so far
+// and the total size, -1 when the size is unknown. It is called from the goroutine streaming the file.
+func WithUploadProgress(fn func(written, total int64)) UploadOption {
+	return func(c *uploadConfig) {
+		c.progress = fn
+	}
+}
+
+// Upload uploads the content of r as filename and returns its hosted URL, e.g. for file attachment comments
+// and avatars. The content is streamed as multipart form data, it is not buffered in memory, so the request
+// is never retried. A file larger than the size limit is rejected with a validation error wrapping ErrFileTooLarge.
+func (s *SDKImpl) Upload(ctx context.Context, filename string, r io.Reader, opts ...UploadOption) (*UploadResponse, *qiscus.Error) {
+	cfg := uploadConfig{maxSize: DefaultMaxUploadSize}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	resp := &UploadResponse{}
+
+	if filename == "" {
+		return resp, uploadError("filename is required", nil)
+	}
+
+	total := sizeOf(r)
+	if total > cfg.maxSize {
+		return resp, tooLargeError(cfg.maxSize)
+	}
+
+	br := bufio.NewReaderSize(r, sniffLen)
+	head, err := br.Peek(sniffLen)
+	if err != nil && err != io.EOF {
+		return resp, &qiscus.Error{
+			Message:  "upload failed, cannot read file: " + err.Error(),
+			RawError: err,
+		}
+	}
+
+	if len(head) == 0 {
+		return resp, uploadError("file is empty", nil)
+	}
+
+	contentType := cfg.contentType
+	if contentType == "" {
+		contentType = detectContentType(filename, head)
+	}
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+
+	// The form is written while the request is sent, the write error is read once the request is done
+	written := make(chan error, 1)
+	go func() {
+		err := writeUploadForm(mw, filename, contentType, br, &progressWriter{
+			max:      cfg.maxSize,
+			total:    total,
+			progress: cfg.progress,
+		})
+		pw.CloseWithError(err)
+		written <- err
+	}()
+
+	url := fmt.Sprintf("%s/api/v2.1/rest/upload", s.APIBase())
+
+	// Requests of qiscus.NewHttpRequest always accept the HTTP settings
+	req := s.newRequest(http.MethodPost, url, pr, resp)
+	settings := req.(qiscus.HttpRequestSettings)
+	settings.SetContentType(mw.FormDataContentType())
+	settings.SetStreaming(true)
+	e := qiscus.DoRequestContext(ctx, req)
+
+	// Unblock the writer when the request ended before the whole form is sent
+	pr.Close()
+	writeErr := <-written
+
+	// The size limit aborts the request, the request error is then only a consequence of it.
+	// Otherwise the request error comes first, e.g. a 401 answered before the whole form is sent,
+	// which makes the writer fail with a closed pipe.
+	switch {
+	case errors.Is(writeErr, ErrFileTooLarge):
+		return resp, tooLargeError(cfg.maxSize)
+	case e != nil:
+		return resp, e
+	case writeErr != nil && !errors.Is(writeErr, io.ErrClosedPipe):
+		return resp, &qiscus.Error{
+			Message:  "upload failed, cannot read file: " + writeErr.Error(),
+			RawError: writeErr,
+		}
+	}
+
+	return resp, nil
+}
+
+// writeUploadForm writes the file part of the upload form, copying r through w
+func writeUploadForm(mw *multipart.Writer, filename, contentType string, r io.Reader, w *progressWriter) error {
+	quote := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, quote.Replace(filename)))
+	header.Set("Content-Type", contentType)
+
+	part, err := mw.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	w.w = part
+	if _, err := io.Copy(w, r); err != nil {
+		return err
+	}
+
+	return mw.Close()
+}
+
+// progressWriter counts the bytes written, enforces the size limit and reports the progress
+type progressWriter struct {
+	w        io.Writer
+	written  int64
+	max      int64
+	total    int64
+	progress func(written, total int64)
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	if p.written+int64(len(b)) > p.max {
+		return 0, ErrFileTooLarge
+	}
+
+	n, err := p.w.Write(b)
+	p.written += int64(n)
+	if p.progress != nil && n > 0 {
+		p.progress(p.written, p.total)
+	}
+
+	return n, err
+}
+
+// sizeOf returns the size of r when it is known, e.g. of files and in-memory readers, or -1
+func sizeOf(r io.Reader) int64 {
+	switch v := r.(type) {
+	case interface{ Len() int }:
+		return int64(v.Len())
+	case *os.File:
+		if info, err := v.Stat(); err == nil && info.Mode().IsRegular() {
+			if offset, err := v.Seek(0, io.SeekCurrent); err == nil {
+				return info.Size() - offset
+			}
+		}
+	}
+
+	return -1
+}
+
+// detectContentType sniffs the content type of the file, the file extension is used when sniffing is not conclusive
+func detectContentType(filename string, head []byte) string {
+	sniffed := http.DetectContentType(head)
+	if sniffed != "application/octet-stream" && !strings.HasPrefix(sniffed, "text/plain") {
+		return sniffed
+	}
+
+	if byExt := mime.TypeByExtension(filepath.Ext(filename)); byExt != "" {
+		return byExt
+	}
+
+	return sniffed
+}
+
+// uploadError returns a validation error of the file field
+func uploadError(message string, raw error) *qiscus.Error {
+	return &qiscus.Error{
+		Message:     "upload failed. " + message,
+		RawError:    raw,
+		FieldErrors: map[string][]string{"file": {message}},
+	}
+}
+
+// tooLargeError returns the validation error of a file larger than max bytes
+func tooLargeError(max int64) *qiscus.Error {
+	return uploadError(fmt.Sprintf("file must not be larger than %d bytes", max), ErrFileTooLarge)
+}
@@ -0,0 +1,153 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+	"github.com/stretchr/testify/assert"
+)
+
+// pngHeader is the signature of a PNG file
+var pngHeader = []byte("\x89PNG\r\n\x1a\n")
+
+func TestUpload(t *testing.T) {
+	data := append(append([]byte(nil), pngHeader...), bytes.Repeat([]byte{1}, 100<<10)...)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, req.Method, http.MethodPost)
+		assert.Equal(t, req.URL.Path, "/api/v2.1/rest/upload")
+		assert.Equal(t, req.Header.Get("QISCUS_SDK_APP_ID"), qiscusAppID)
+		assert.Equal(t, req.Header.Get("QISCUS_SDK_SECRET"), qiscusSecretKey)
+
+		file, header, err := req.FormFile("file")
+		assert.Nil(t, err)
+		assert.Equal(t, header.Filename, "avatar.png")
+		assert.Equal(t, header.Header.Get("Content-Type"), "image/png")
+
+		got, _ := ioutil.ReadAll(file)
+		assert.Equal(t, got, data)
+
+		fmt.Fprint(w, `{"results":{"file":{"url":"https://files.qiscus.com/avatar.png"}},"status":200}`)
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	var written, total int64
+	result, err := c.Upload(context.Background(), "avatar.png", bytes.NewReader(data), WithUploadProgress(func(w, t int64) {
+		written, total = w, t
+	}))
+	assert.Nil(t, err)
+	assert.Equal(t, result.URL(), "https://files.qiscus.com/avatar.png")
+	assert.Equal(t, written, int64(len(data)))
+	assert.Equal(t, total, int64(len(data)))
+}
+
+func TestUploadTooLarge(t *testing.T) {
+	var requests int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		_, _ = io.Copy(ioutil.Discard, req.Body)
+		fmt.Fprint(w, `{"results":{"file":{"url":"https://files.qiscus.com/big.bin"}},"status":200}`)
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+	data := bytes.Repeat([]byte("a"), 2048)
+
+	// The size of a bytes.Reader is known, the file is rejected without calling the API
+	_, err := c.Upload(context.Background(), "big.txt", bytes.NewReader(data), WithMaxUploadSize(1024))
+	assert.True(t, errors.Is(err, qiscus.ErrValidation))
+	assert.True(t, errors.Is(err, ErrFileTooLarge))
+	assert.Equal(t, atomic.LoadInt32(&requests), int32(0))
+
+	// The size of a stream is unknown, the upload is aborted once the limit is exceeded
+	_, err = c.Upload(context.Background(), "big.txt", ioutil.NopCloser(bytes.NewReader(data)), WithMaxUploadSize(1024))
+	assert.True(t, errors.Is(err, ErrFileTooLarge))
+	assert.Contains(t, err.GetFieldErrors(), "file")
+}
+
+func TestUploadValidation(t *testing.T) {
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase("http://127.0.0.1:0"))
+
+	result, err := c.Upload(context.Background(), "", strings.NewReader("hello"))
+	assert.True(t, errors.Is(err, qiscus.ErrValidation))
+	assert.NotNil(t, result)
+
+	result, err = c.Upload(context.Background(), "empty.txt", strings.NewReader(""))
+	assert.True(t, errors.Is(err, qiscus.ErrValidation))
+	assert.NotNil(t, result)
+	assert.Equal(t, err.GetFieldErrors()["file"], []string{"file is empty"})
+}
+
+func TestUploadUnauthorized(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		// Answer without reading the form
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"error":{"message":"unauthorized"},"status":401}`)
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL))
+
+	result, err := c.Upload(context.Background(), "big.bin", ioutil.NopCloser(bytes.NewReader(bytes.Repeat([]byte("a"), 1<<20))))
+	assert.NotNil(t, result)
+	assert.True(t, errors.Is(err, qiscus.ErrUnauthorized))
+	assert.Equal(t, err.GetStatusCode(), http.StatusUnauthorized)
+}
+
+func TestUploadNotRetried(t *testing.T) {
+	var attempts int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+
+	defer srv.Close()
+
+	c := NewSDK(qiscusAppID, qiscusSecretKey, WithAPIBase(srv.URL), WithRetryPolicy(&qiscus.RetryPolicy{
+		MaxAttempts:          3,
+		InitialBackoff:       time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusServiceUnavailable},
+		RetryNonIdempotent:   true,
+	}))
+
+	_, err := c.Upload(context.Background(), "hello.txt", strings.NewReader("hello"))
+	assert.Equal(t, err.GetStatusCode(), http.StatusServiceUnavailable)
+	assert.Equal(t, atomic.LoadInt32(&attempts), int32(1))
+}
+
+func TestDetectContentType(t *testing.T) {
+	tests := []struct {
+		filename string
+		head     []byte
+		want     string
+	}{
+		{"avatar.png", pngHeader, "image/png"},
+		{"avatar.jpg", pngHeader, "image/png"},
+		{"doc.pdf", []byte("%PDF-1.4"), "application/pdf"},
+		{"data.json", []byte(`{"a":1}`), "application/json"},
+		{"notes", []byte("hello"), "text/plain; charset=utf-8"},
+		{"blob", []byte{0, 1, 2, 3}, "application/octet-stream"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, detectContentType(tt.filename, tt.head), tt.want, tt.filename)
+	}
+}
@@ -0,0 +1,173 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Qiscus-Integration/qiscus-go"
+	"github.com/Qiscus-Integration/qiscus-go/sdk"
+)
+
+// Event types sent by Qiscus SDK webhook
+const (
+	EventPostCommentMobile = "post_comment_mobile" // comment posted from client SDK
+	EventPostCommentRest   = "post_comment_rest"   // comment posted from REST API
+)
+
+// Event is Represent a raw webhook payload, Payload is decoded according to Type
+type Event struct {
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// Room is Represent the room of a webhook payload, the sdk room with its participants
+type Room struct {
+	sdk.Room
+	Participants []sdk.Participant `json:"participants"`
+}
+
+// Comment is Represent the comment of a webhook payload, the sdk comment with the fields only sent in webhooks.
+// It is encoded to JSON as the sdk comment.
+type Comment struct {
+	sdk.Comment
+	CommentBeforeID    int64
+	CreatedAt          qiscus.Time
+	DisableLinkPreview bool
+	UnixTimestamp      int64
+	UnixNanoTimestamp  int64
+}
+
+// PostCommentEvent is Represent post comment webhook payload.
+// The user of Message is the sender, and its payload is decoded according to its type, see sdk.DecodePayload.
+type PostCommentEvent struct {
+	Type    string   `json:"-"`
+	From    sdk.User `json:"from"`
+	Room    Room     `json:"room"`
+	Message Comment  `json:"message"`
+}
+
+// PostComment decodes the payload of post comment event
+func (e *Event) PostComment() (*PostCommentEvent, error) {
+	if e.Type != EventPostCommentMobile && e.Type != EventPostCommentRest {
+		return nil, fmt.Errorf("webhook event %q is not a post comment event", e.Type)
+	}
+
+	event := &PostCommentEvent{}
+	if err := json.Unmarshal(e.Payload, event); err != nil {
+		return nil, fmt.Errorf("invalid post comment webhook payload: %s", err.Error())
+	}
+	event.Type = e.Type
+
+	return event, nil
+}
+
+// UnmarshalJSON decodes the post comment payload of webhooks into sdk shapes
+func (e *PostCommentEvent) UnmarshalJSON(data []byte) error {
+	raw := rawPostComment{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	e.From = raw.From.user()
+	e.Room = raw.Room.room()
+	e.Message = raw.Message.comment(e.From)
+
+	return nil
+}
+
+// rawPostComment is the wire shape of post comment webhook payload, it differs from REST API shapes
+type rawPostComment struct {
+	From    payloadUser    `json:"from"`
+	Room    payloadRoom    `json:"room"`
+	Message payloadComment `json:"message"`
+}
+
+type payloadUser struct {
+	ID        int64  `json:"id"`
+	IDStr     string `json:"id_str"`
+	Email     string `json:"email"` // user ID used in REST API
+	Name      string `json:"name"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+type payloadRoom struct {
+	ID           string        `json:"id"`
+	IDStr        string        `json:"id_str"`
+	Name         string        `json:"name"`
+	Options      string        `json:"options"`
+	Participants []payloadUser `json:"participants"`
+	RoomAvatar   string        `json:"room_avatar"`
+	TopicID      string        `json:"topic_id"`
+	TopicIDStr   string        `json:"topic_id_str"`
+	Type         string        `json:"type"`
+}
+
+type payloadComment struct {
+	CommentBeforeID    int64                  `json:"comment_before_id"`
+	CommentBeforeIDStr string                 `json:"comment_before_id_str"`
+	CreatedAt          qiscus.Time            `json:"created_at"`
+	DisableLinkPreview bool                   `json:"disable_link_preview"`
+	Extras             map[string]interface{} `json:"extras"`
+	ID                 int64                  `json:"id"`
+	IDStr              string                 `json:"id_str"`
+	Payload            json.RawMessage        `json:"payload"`
+	Text               string                 `json:"text"`
+	Timestamp          qiscus.Time            `json:"timestamp"`
+	Type               string                 `json:"type"`
+	UniqueTempID       string                 `json:"unique_temp_id"`
+	UnixNanoTimestamp  int64                  `json:"unix_nano_timestamp"`
+	UnixTimestamp      int64                  `json:"unix_timestamp"`
+}
+
+func (u payloadUser) user() sdk.User {
+	return sdk.User{
+		Active:    true, // only active users can post comments
+		AvatarURL: u.AvatarURL,
+		UserID:    u.Email,
+		Username:  u.Name,
+	}
+}
+
+func (r payloadRoom) room() Room {
+	room := Room{
+		Room: sdk.Room{
+			RoomAvatarURL: r.RoomAvatar,
+			RoomID:        r.ID,
+			RoomName:      r.Name,
+			RoomOptions:   r.Options,
+			RoomType:      r.Type,
+		},
+	}
+
+	for _, p := range r.Participants {
+		room.Participants = append(room.Participants, p.user())
+	}
+
+	return room
+}
+
+func (c payloadComment) comment(from sdk.User) Comment {
+	payload, err := sdk.DecodePayload(c.Type, c.Payload)
+	if err != nil {
+		payload = c.Payload
+	}
+
+	return Comment{
+		Comment: sdk.Comment{
+			Extras:     c.Extras,
+			ID:         int(c.ID),
+			Message:    c.Text,
+			Timestamp:  c.Timestamp,
+			Type:       c.Type,
+			UniqueID:   c.UniqueTempID,
+			User:       from,
+			Payload:    payload,
+			RawPayload: c.Payload,
+		},
+		CommentBeforeID:    c.CommentBeforeID,
+		CreatedAt:          c.CreatedAt,
+		DisableLinkPreview: c.DisableLinkPreview,
+		UnixTimestamp:      c.UnixTimestamp,
+		UnixNanoTimestamp:  c.UnixNanoTimestamp,
+	}
+}
@@ -0,0 +1,119 @@
+// Package webhook provides an http.Handler to receive Qiscus SDK webhooks.
+//
+// Register callbacks per event type, then mount the handler on the webhook URL configured in the Qiscus dashboard:
+//
+//	h := webhook.NewHandler()
+//	h.HandlePostComment(func(ctx context.Context, e *webhook.PostCommentEvent) error {
+//		fmt.Println(e.From.UserID, e.Message.Message)
+//		return nil
+//	})
+//	http.Handle("/qiscus/webhook", h)
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+// MaxBodySize is the maximum size of webhook payload accepted by Handler
+const MaxBodySize = 10 << 20
+
+// EventFunc is a callback for raw webhook event
+type EventFunc func(ctx context.Context, event *Event) error
+
+// PostCommentFunc is a callback for post comment webhook event
+type PostCommentFunc func(ctx context.Context, event *PostCommentEvent) error
+
+// Handler decodes incoming webhook payloads and dispatches them to the registered callbacks.
+// Events without registered callback are acknowledged and ignored.
+type Handler struct {
+	mu       sync.RWMutex
+	handlers map[string][]EventFunc
+}
+
+// NewHandler creates a new webhook handler
+func NewHandler() *Handler {
+	return &Handler{handlers: make(map[string][]EventFunc)}
+}
+
+// HandleEvent registers a callback for raw event of the given type
+func (h *Handler) HandleEvent(eventType string, fn EventFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.handlers[eventType] = append(h.handlers[eventType], fn)
+}
+
+// HandlePostComment registers a callback for comments posted from both client SDK and REST API
+func (h *Handler) HandlePostComment(fn PostCommentFunc) {
+	cb := func(ctx context.Context, e *Event) error {
+		event, err := e.PostComment()
+		if err != nil {
+			return err
+		}
+		return fn(ctx, event)
+	}
+
+	h.HandleEvent(EventPostCommentMobile, cb)
+	h.HandleEvent(EventPostCommentRest, cb)
+}
+
+// Dispatch calls the callbacks registered for the event type, it stops at the first error
+func (h *Handler) Dispatch(ctx context.Context, event *Event) error {
+	h.mu.RLock()
+	handlers := h.handlers[event.Type]
+	h.mu.RUnlock()
+
+	for _, fn := range handlers {
+		if err := fn(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ServeHTTP implements http.Handler.
+// It responds 400 when the payload is invalid and 500 when a callback returns an error, so Qiscus can record the failure.
+// The callback error is logged with the global zerolog logger, it is not sent back in the response.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	event, err := Parse(http.MaxBytesReader(w, r.Body, MaxBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Dispatch(r.Context(), event); err != nil {
+		log.Error().Err(err).Str("type", event.Type).Msg("qiscus webhook callback failed")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Parse decodes a webhook payload
+func Parse(r io.Reader) (*Event, error) {
+	event := &Event{}
+	if err := json.NewDecoder(r).Decode(event); err != nil {
+		return nil, fmt.Errorf("invalid webhook payload: %s", err.Error())
+	}
+
+	if event.Type == "" {
+		return nil, fmt.Errorf("invalid webhook payload: missing event type")
+	}
+
+	return event, nil
+}
@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Qiscus-Integration/qiscus-go/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+const postCommentPayload = `{
+	"type": "post_comment_mobile",
+	"payload": {
+		"from": {"id": 1, "id_str": "1", "email": "guest@mail.com", "name": "Guest", "avatar_url": "https://example.com/avatar.svg"},
+		"room": {"id": "123123", "id_str": "123123", "name": "Room sample", "type": "group", "participants": [{"id": 1, "email": "guest@mail.com", "name": "Guest"}]},
+		"message": {"id": 10, "id_str": "10", "text": "hello", "type": "text", "payload": {}, "unique_temp_id": "temp-10", "created_at": "2021-09-20 07:32:24", "timestamp": "2021-09-20T07:32:24Z"}
+	}
+}`
+
+func TestHandlePostComment(t *testing.T) {
+	var received *PostCommentEvent
+
+	h := NewHandler()
+	h.HandlePostComment(func(ctx context.Context, e *PostCommentEvent) error {
+		received = e
+		return nil
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(postCommentPayload))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(t, rec.Code, http.StatusOK)
+	assert.NotNil(t, received)
+	assert.Equal(t, received.Type, EventPostCommentMobile)
+	assert.Equal(t, received.From.UserID, "guest@mail.com")
+	assert.Equal(t, received.From.Username, "Guest")
+	assert.Equal(t, received.Room.RoomID, "123123")
+	assert.Equal(t, received.Room.RoomType, "group")
+	assert.Equal(t, received.Room.Participants[0].Username, "Guest")
+	assert.Equal(t, received.Message.ID, 10)
+	assert.Equal(t, received.Message.Message, "hello")
+	assert.Equal(t, received.Message.UniqueID, "temp-10")
+	assert.Equal(t, received.Message.User, received.From)
+	assert.True(t, received.Message.CreatedAt.Equal(received.Message.Timestamp.Time))
+}
+
+func TestHandleEvent(t *testing.T) {
+	var called bool
+
+	h := NewHandler()
+	h.HandleEvent("custom_event", func(ctx context.Context, e *Event) error {
+		called = true
+		return errors.New("callback failed")
+	})
+
+	// Unregistered event type is acknowledged and ignored
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(postCommentPayload)))
+	assert.Equal(t, rec.Code, http.StatusOK)
+	assert.False(t, called)
+
+	// Callback error is reported to Qiscus
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(`{"type":"custom_event","payload":{}}`)))
+	assert.Equal(t, rec.Code, http.StatusInternalServerError)
+	assert.NotContains(t, rec.Body.String(), "callback failed")
+	assert.True(t, called)
+
+	// Invalid payload and method
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(`{"payload":{}}`)))
+	assert.Equal(t, rec.Code, http.StatusBadRequest)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/webhook", nil))
+	assert.Equal(t, rec.Code, http.StatusMethodNotAllowed)
+}
+
+func TestPostCommentDecodePayload(t *testing.T) {
+	event := &Event{
+		Type:    EventPostCommentRest,
+		Payload: []byte(`{"from":{"email":"bot@mail.com"},"room":{"id":"123123"},"message":{"id":11,"type":"buttons","payload":{"text":"Choose","buttons":[{"label":"Yes","type":"postback","postback_text":"yes"}]}}}`),
+	}
+
+	e, err := event.PostComment()
+	assert.Nil(t, err)
+	assert.Equal(t, e.Message.Payload.(*sdk.ButtonsPayload).Buttons[0].PostbackText, "yes")
+
+	_, err = (&Event{Type: "custom_event"}).PostComment()
+	assert.NotNil(t, err)
+}
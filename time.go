@@ -0,0 +1,159 @@
+package qiscus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateTimeLayout is the layout of date time parameters sent to Qiscus API, in UTC
+const DateTimeLayout = "2006-01-02 15:04:05"
+
+// FormatDateTime formats t as a date time parameter of Qiscus API, see DateTimeLayout.
+// The zero time is formatted as an empty string.
+func FormatDateTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(DateTimeLayout)
+}
+
+// Layouts of the date strings returned by Qiscus API, dates without zone are in UTC
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999 -0700",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// Time is a time decoded from any date format returned by Qiscus API:
+//   - RFC 3339 strings, e.g. "2021-09-20T07:32:24Z"
+//   - date time strings without zone in UTC, e.g. "2021-09-20 07:32:24" and "2021-09-20"
+//   - unix timestamps in seconds, milliseconds, microseconds or nanoseconds, as number or string
+//   - PHP DateTime objects, e.g. {"date": "2021-09-20 07:32:24.000000", "timezone_type": 3, "timezone": "UTC"}
+//
+// null and "" decode to the zero time. Time is encoded as RFC 3339, or null when it is zero.
+type Time struct {
+	time.Time
+}
+
+// NewTime returns t as a Time
+func NewTime(t time.Time) Time {
+	return Time{Time: t}
+}
+
+// phpDateTime is the JSON shape of a PHP DateTime
+type phpDateTime struct {
+	Date         string `json:"date"`
+	TimezoneType int    `json:"timezone_type"`
+	Timezone     string `json:"timezone"`
+}
+
+// UnmarshalJSON decodes any date format returned by Qiscus API
+func (t *Time) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+
+	switch {
+	case len(b) == 0 || bytes.Equal(b, []byte("null")):
+		t.Time = time.Time{}
+		return nil
+	case b[0] == '{':
+		var php phpDateTime
+		if err := json.Unmarshal(b, &php); err != nil {
+			return fmt.Errorf("qiscus: invalid date object %s: %w", b, err)
+		}
+		return t.parsePHP(php)
+	case b[0] == '"':
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return fmt.Errorf("qiscus: invalid date %s: %w", b, err)
+		}
+		return t.parse(s)
+	default:
+		return t.parse(string(b))
+	}
+}
+
+// MarshalJSON encodes the time as RFC 3339, or null when it is zero
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return t.Time.MarshalJSON()
+}
+
+// parse parses a date string or a unix timestamp
+func (t *Time) parse(s string) error {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	if unix, err := strconv.ParseFloat(s, 64); err == nil {
+		t.Time = fromUnix(unix)
+		return nil
+	}
+
+	for _, layout := range timeLayouts {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+
+	return fmt.Errorf("qiscus: unknown date format %q", s)
+}
+
+// parsePHP parses a PHP DateTime, the date is in the timezone of the object
+func (t *Time) parsePHP(php phpDateTime) error {
+	loc := time.UTC
+
+	switch tz := php.Timezone; {
+	case tz == "" || tz == "UTC" || tz == "Z" || tz == "GMT":
+	case strings.HasPrefix(tz, "+") || strings.HasPrefix(tz, "-"):
+		// Timezone type 1 is an offset, e.g. +07:00
+		offset, err := time.Parse("-07:00", tz)
+		if err != nil {
+			return fmt.Errorf("qiscus: unknown timezone %q", tz)
+		}
+		_, seconds := offset.Zone()
+		loc = time.FixedZone(tz, seconds)
+	default:
+		// Timezone type 2 is an abbreviation and type 3 an identifier, e.g. Asia/Jakarta
+		l, err := time.LoadLocation(tz)
+		if err != nil {
+			return fmt.Errorf("qiscus: unknown timezone %q: %w", tz, err)
+		}
+		loc = l
+	}
+
+	for _, layout := range timeLayouts[3:] {
+		if parsed, err := time.ParseInLocation(layout, php.Date, loc); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+
+	return fmt.Errorf("qiscus: unknown date format %q", php.Date)
+}
+
+// fromUnix returns the time of a unix timestamp, guessing its unit from its magnitude
+func fromUnix(unix float64) time.Time {
+	switch {
+	case unix < 1e11:
+		sec, frac := int64(unix), unix-float64(int64(unix))
+		return time.Unix(sec, int64(frac*1e9)).UTC()
+	case unix < 1e14:
+		return time.Unix(0, int64(unix)*int64(time.Millisecond)).UTC()
+	case unix < 1e17:
+		return time.Unix(0, int64(unix)*int64(time.Microsecond)).UTC()
+	default:
+		return time.Unix(0, int64(unix)).UTC()
+	}
+}
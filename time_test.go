@@ -0,0 +1,79 @@
+package qiscus
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeUnmarshalJSON(t *testing.T) {
+	want := time.Date(2021, 9, 20, 7, 32, 24, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		data string
+		want time.Time
+	}{
+		{"rfc3339", `"2021-09-20T07:32:24Z"`, want},
+		{"rfc3339 with offset", `"2021-09-20T14:32:24+07:00"`, want},
+		{"rfc3339 with fraction", `"2021-09-20T07:32:24.5Z"`, want.Add(500 * time.Millisecond)},
+		{"date time", `"2021-09-20 07:32:24"`, want},
+		{"date time with offset", `"2021-09-20 14:32:24+07:00"`, want},
+		{"date time with fraction", `"2021-09-20 07:32:24.000000"`, want},
+		{"date time without zone", `"2021-09-20T07:32:24"`, want},
+		{"date", `"2021-09-20"`, time.Date(2021, 9, 20, 0, 0, 0, 0, time.UTC)},
+		{"unix seconds", `1632123144`, want},
+		{"unix seconds string", `"1632123144"`, want},
+		{"unix milliseconds", `1632123144000`, want},
+		{"unix microseconds", `1632123144000000`, want},
+		{"unix nanoseconds", `1632123144000000000`, want},
+		{"php utc", `{"date":"2021-09-20 07:32:24.000000","timezone_type":3,"timezone":"UTC"}`, want},
+		{"php offset", `{"date":"2021-09-20 14:32:24.000000","timezone_type":1,"timezone":"+07:00"}`, want},
+		{"null", `null`, time.Time{}},
+		{"empty", `""`, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Time
+			err := json.Unmarshal([]byte(tt.data), &got)
+			assert.Nil(t, err)
+			assert.True(t, got.Equal(tt.want), "got %s", got)
+		})
+	}
+}
+
+func TestTimeUnmarshalJSONLocation(t *testing.T) {
+	if _, err := time.LoadLocation("Asia/Jakarta"); err != nil {
+		t.Skip("time zone database is not available")
+	}
+
+	var got Time
+	err := json.Unmarshal([]byte(`{"date":"2021-09-20 14:32:24.000000","timezone_type":3,"timezone":"Asia/Jakarta"}`), &got)
+	assert.Nil(t, err)
+	assert.True(t, got.Equal(time.Date(2021, 9, 20, 7, 32, 24, 0, time.UTC)))
+}
+
+func TestTimeUnmarshalJSONInvalid(t *testing.T) {
+	for _, data := range []string{`"yesterday"`, `true`, `{"date":"2021-09-20 07:32:24","timezone":"Mars/Olympus"}`} {
+		var got Time
+		assert.NotNil(t, json.Unmarshal([]byte(data), &got), data)
+	}
+}
+
+func TestTimeMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(struct {
+		CreatedAt Time `json:"created_at"`
+		DeletedAt Time `json:"deleted_at"`
+	}{CreatedAt: NewTime(time.Date(2021, 9, 20, 7, 32, 24, 0, time.UTC))})
+	assert.Nil(t, err)
+	assert.Equal(t, string(b), `{"created_at":"2021-09-20T07:32:24Z","deleted_at":null}`)
+}
+
+func TestFormatDateTime(t *testing.T) {
+	wib := time.FixedZone("WIB", 7*60*60)
+	assert.Equal(t, FormatDateTime(time.Date(2021, 9, 20, 14, 32, 24, 0, wib)), "2021-09-20 07:32:24")
+	assert.Equal(t, FormatDateTime(time.Time{}), "")
+}